@@ -0,0 +1,10 @@
+data "commonfate_access_workflow" "production" {
+  name = "production"
+}
+
+resource "commonfate_aws_idc_account_availabilities" "production" {
+  workflow_id             = data.commonfate_access_workflow.production.id
+  aws_permission_set_arn  = "arn:aws:sso:::permissionSet/ssoins-12345667879812/ps-12345678912"
+  aws_account_selector_id = "selector_id"
+  aws_identity_store_id   = "d-12345678"
+}
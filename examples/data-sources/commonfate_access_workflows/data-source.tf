@@ -0,0 +1,7 @@
+data "commonfate_access_workflows" "production" {
+  name_prefix = "prod-"
+}
+
+output "production_workflow_ids" {
+  value = data.commonfate_access_workflows.production.workflows[*].id
+}
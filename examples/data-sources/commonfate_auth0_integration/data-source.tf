@@ -0,0 +1,3 @@
+data "commonfate_auth0_integration" "main" {
+  name = "main"
+}
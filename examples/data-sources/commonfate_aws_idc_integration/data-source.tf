@@ -0,0 +1,12 @@
+data "commonfate_aws_idc_integration" "main" {
+  name = "main"
+}
+
+data "commonfate_entities" "permission_sets" {
+  type = "AWS::IDC::PermissionSet"
+
+  belonging_to = {
+    type = "AWS::IDC::Instance"
+    id   = data.commonfate_aws_idc_integration.main.sso_instance_arn
+  }
+}
@@ -0,0 +1,15 @@
+data "commonfate_aws_idc_permission_sets" "main" {
+  aws_idc_integration_id = commonfate_aws_idc_integration.main.id
+}
+
+resource "commonfate_aws_idc_account_availabilities" "main" {
+  for_each = {
+    for ps in data.commonfate_aws_idc_permission_sets.main.permission_sets : ps.name => ps.arn
+    if contains(["AdministratorAccess", "ReadOnlyAccess"], ps.name)
+  }
+
+  workflow_id             = commonfate_access_workflow.aws.id
+  aws_permission_set_arn  = each.value
+  aws_account_selector_id = commonfate_aws_account_selector.all.id
+  aws_identity_store_id   = commonfate_aws_idc_integration.main.identity_store_id
+}
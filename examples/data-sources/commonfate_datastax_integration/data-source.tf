@@ -0,0 +1,3 @@
+data "commonfate_datastax_integration" "main" {
+  name = "main"
+}
@@ -0,0 +1,17 @@
+data "commonfate_entities" "permission_sets" {
+  type = "AWS::IDC::PermissionSet"
+
+  belonging_to = {
+    type = "AWS::IDC::Instance"
+    id   = "arn:aws:sso:::instance/ssoins-1234567890abcdef"
+  }
+}
+
+resource "commonfate_aws_idc_account_availabilities" "permission_sets" {
+  for_each = { for e in data.commonfate_entities.permission_sets.entities : e.name => e.eid.id }
+
+  workflow_id             = commonfate_access_workflow.aws.id
+  aws_permission_set_arn  = each.value
+  aws_account_selector_id = commonfate_aws_account_selector.all.id
+  aws_identity_store_id   = "d-12345678"
+}
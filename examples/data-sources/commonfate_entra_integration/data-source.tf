@@ -0,0 +1,3 @@
+data "commonfate_entra_integration" "main" {
+  name = "main"
+}
@@ -0,0 +1,3 @@
+data "commonfate_gcp_integration" "main" {
+  name = "main"
+}
@@ -0,0 +1,20 @@
+data "commonfate_gcp_roles" "main" {
+  gcp_integration_id = commonfate_gcp_integration.main.id
+}
+
+locals {
+  bigquery_roles = ["roles/bigquery.dataViewer", "roles/bigquery.dataEditor"]
+}
+
+resource "commonfate_gcp_role_group" "bigquery" {
+  name                = "BigQuery"
+  gcp_organization_id = commonfate_gcp_integration.main.organization_id
+  role_ids            = local.bigquery_roles
+
+  lifecycle {
+    precondition {
+      condition     = alltrue([for id in local.bigquery_roles : contains(data.commonfate_gcp_roles.main.ids, id)])
+      error_message = "Every role in the role group must exist in GCP."
+    }
+  }
+}
@@ -0,0 +1,12 @@
+data "commonfate_import_ids" "workflows" {
+  resource_type = "commonfate_access_workflow"
+}
+
+# renders an import block for each existing access workflow. Save the output to a .tf file
+# and run `terraform plan -generate-config-out=workflows.tf` to write their configuration.
+output "access_workflow_imports" {
+  value = join("\n", [
+    for id in data.commonfate_import_ids.workflows.ids :
+    "import {\n  to = commonfate_access_workflow.${replace(id, "-", "_")}\n  id = \"${id}\"\n}\n"
+  ])
+}
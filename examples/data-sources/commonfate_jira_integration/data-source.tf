@@ -0,0 +1,3 @@
+data "commonfate_jira_integration" "main" {
+  name = "main"
+}
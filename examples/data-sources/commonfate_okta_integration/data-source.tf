@@ -0,0 +1,3 @@
+data "commonfate_okta_integration" "main" {
+  name = "main"
+}
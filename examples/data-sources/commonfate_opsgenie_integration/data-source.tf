@@ -0,0 +1,3 @@
+data "commonfate_opsgenie_integration" "main" {
+  name = "main"
+}
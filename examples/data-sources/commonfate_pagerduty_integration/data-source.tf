@@ -0,0 +1,3 @@
+data "commonfate_pagerduty_integration" "main" {
+  name = "main"
+}
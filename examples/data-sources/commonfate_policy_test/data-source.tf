@@ -0,0 +1,47 @@
+resource "commonfate_policyset" "engineering" {
+  id   = "engineering"
+  text = <<EOT
+permit (
+  principal in CF::Group::"engineering",
+  action == Access::Action::"Request",
+  resource in AWS::OrgUnit::"ou-dev"
+);
+EOT
+}
+
+# fails the plan if engineers can no longer request access to the dev account.
+data "commonfate_policy_test" "engineers_can_request_dev" {
+  principal = { type = "CF::User", id = "usr_alice" }
+  action    = { type = "Access::Action", id = "Request" }
+  resource  = { type = "AWS::Account", id = "123456789012" }
+  expect    = "allow"
+
+  depends_on = [commonfate_policyset.engineering]
+}
+
+data "commonfate_policy_test" "contractors_cannot_request_prod" {
+  principal = { type = "CF::User", id = "usr_contractor" }
+  action    = { type = "Access::Action", id = "Request" }
+  resource  = { type = "AWS::Account", id = "210987654321" }
+  expect    = "deny"
+
+  depends_on = [commonfate_policyset.engineering]
+}
+
+# checks that adding a new starter to the engineering group will let them request dev access,
+# before the membership has been synced.
+data "commonfate_policy_test" "new_engineer_can_request_dev" {
+  principal = { type = "CF::User", id = "usr_new_starter" }
+  action    = { type = "Access::Action", id = "Request" }
+  resource  = { type = "AWS::Account", id = "123456789012" }
+  expect    = "allow"
+
+  overlay_children = [
+    {
+      parent = { type = "CF::Group", id = "engineering" }
+      child  = { type = "CF::User", id = "usr_new_starter" }
+    },
+  ]
+
+  depends_on = [commonfate_policyset.engineering]
+}
@@ -0,0 +1,10 @@
+data "commonfate_policysets" "all" {}
+
+# renders an import block for each existing policyset. Save the output to a .tf file
+# and run `terraform plan -generate-config-out=policysets.tf` to write their configuration.
+output "policyset_imports" {
+  value = join("\n", [
+    for ps in data.commonfate_policysets.all.policysets :
+    "import {\n  to = commonfate_policyset.${replace(ps.id, "-", "_")}\n  id = \"${ps.id}\"\n}\n"
+  ])
+}
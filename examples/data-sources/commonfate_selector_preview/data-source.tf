@@ -0,0 +1,25 @@
+locals {
+  production_accounts = "resource.tags contains {key: \"environment\", value: \"production\"}"
+}
+
+resource "commonfate_aws_account_selector" "production" {
+  id                  = "production"
+  name                = "Production accounts"
+  aws_organization_id = "o-123456789a"
+  when                = local.production_accounts
+}
+
+# shows the accounts the selector matches in the plan.
+data "commonfate_selector_preview" "production" {
+  resource_type = "AWS::Account"
+  when          = local.production_accounts
+
+  belonging_to = {
+    type = "AWS::Organization"
+    id   = "o-123456789a"
+  }
+}
+
+output "production_accounts" {
+  value = data.commonfate_selector_preview.production.matched[*].name
+}
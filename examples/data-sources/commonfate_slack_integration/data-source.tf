@@ -0,0 +1,3 @@
+data "commonfate_slack_integration" "main" {
+  name = "main"
+}
@@ -0,0 +1,3 @@
+data "commonfate_snowflake_integration" "main" {
+  name = "main"
+}
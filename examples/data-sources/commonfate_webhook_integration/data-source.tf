@@ -0,0 +1,3 @@
+data "commonfate_webhook_integration" "main" {
+  name = "main"
+}
@@ -1,7 +1,7 @@
 resource "commonfate-access_workflow" "workflow-demo" {
   name                     = "demo"
-  access_duration_seconds  = 60 * 60 * 2
-  default_duration_seconds = 50 * 60
+  access_duration_seconds  = "2h"
+  default_duration_seconds = "50m"
 
   priority = 100
 
@@ -9,10 +9,10 @@ resource "commonfate-access_workflow" "workflow-demo" {
     has_reason = true
   }
 
-  activation_expiry= 60 * 5
+  activation_expiry= "5m"
 
   extension_conditions = {
-    extension_duration_seconds   = 60 * 30
+    extension_duration_seconds   = "30m"
     maximum_number_of_extensions = 4
   }
 
@@ -8,3 +8,23 @@ resource "commonfate-policyset" "policy-1" {
     );
 EOH
 }
+
+resource "commonfate_policyset" "policy-2" {
+  id = "engineering"
+
+  policies = [
+    {
+      effect = "permit"
+      principal = {
+        in = { type = "CF::Group", id = "engineering" }
+      }
+      action = {
+        eq = { type = "Access::Action", id = "Request" }
+      }
+      resource = {
+        is = "AWS::Account"
+      }
+      when = "resource.tags.contains(\"dev\")"
+    },
+  ]
+}
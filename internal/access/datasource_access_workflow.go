@@ -0,0 +1,374 @@
+package access
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	accessv1alpha1 "github.com/common-fate/sdk/gen/commonfate/access/v1alpha1"
+	configv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1"
+	configv1alpha1connect "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1/configv1alpha1connect"
+	accessworkflow_handler "github.com/common-fate/sdk/service/control/config/accessworkflow"
+	"github.com/common-fate/sdk/service/entity"
+	"github.com/common-fate/terraform-provider-commonfate/internal/cedarpolicy"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// workflowEntityType is the entity type which access workflows are exposed as by the entity service.
+// The config API can't list workflows, so they are listed through the entity service instead.
+const workflowEntityType = "Access::Workflow"
+
+type AccessWorkflowDatasourceModel struct {
+	ID                        types.String                        `tfsdk:"id"`
+	Name                      types.String                        `tfsdk:"name"`
+	AccessDuration            types.Int64                         `tfsdk:"access_duration_seconds"`
+	TryExtendAfter            types.Int64                         `tfsdk:"try_extend_after_seconds"`
+	Priority                  types.Int64                         `tfsdk:"priority"`
+	ActivationExpiry          types.Int64                         `tfsdk:"activation_expiry"`
+	RequestedToApprovedExpiry types.Int64                         `tfsdk:"requested_to_approved_expiry"`
+	RequestedToActivateExpiry types.Int64                         `tfsdk:"requested_to_activate_expiry"`
+	DefaultDuration           types.Int64                         `tfsdk:"default_duration_seconds"`
+	Validation                *Validations                        `tfsdk:"validation"`
+	ExtensionConditions       *ExtensionConditionsDatasourceModel `tfsdk:"extension_conditions"`
+	ApprovalSteps             []ApprovalStep                      `tfsdk:"approval_steps"`
+}
+
+// ExtensionConditionsDatasourceModel reports the extension duration as a number of seconds,
+// unlike the resource, so that it can be used in arithmetic.
+type ExtensionConditionsDatasourceModel struct {
+	MaxExtensions     types.Int64 `tfsdk:"maximum_number_of_extensions"`
+	ExtensionDuration types.Int64 `tfsdk:"extension_duration_seconds"`
+}
+
+// AccessWorkflowDatasource looks up a single access workflow by ID or name.
+type AccessWorkflowDatasource struct {
+	client   configv1alpha1connect.AccessWorkflowServiceClient
+	entities entity.Client
+}
+
+var (
+	_ datasource.DataSource                     = &AccessWorkflowDatasource{}
+	_ datasource.DataSourceWithConfigValidators = &AccessWorkflowDatasource{}
+)
+
+// Metadata returns the data source type name.
+func (r *AccessWorkflowDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_workflow"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *AccessWorkflowDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	cfg := providerData.Config
+
+	r.client = accessworkflow_handler.NewFromConfig(cfg)
+	r.entities = entity.NewFromConfig(cfg)
+}
+
+func (r *AccessWorkflowDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := accessWorkflowDatasourceAttributes()
+	attrs["id"] = schema.StringAttribute{
+		MarkdownDescription: "The ID of the workflow to look up. Exactly one of `id` or `name` must be set.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attrs["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the workflow to look up. Exactly one of `id` or `name` must be set.",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing Access Workflow by ID or name, so that workflows managed outside of this configuration can be referenced.",
+		Attributes:          attrs,
+	}
+}
+
+func (r *AccessWorkflowDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *AccessWorkflowDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HTTP Client",
+			"Expected configured HTTP client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var config AccessWorkflowDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var workflow *configv1alpha1.AccessWorkflow
+
+	if !config.ID.IsNull() {
+		res, err := r.client.GetAccessWorkflow(ctx, connect.NewRequest(&configv1alpha1.GetAccessWorkflowRequest{
+			Id: config.ID.ValueString(),
+		}))
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			resp.Diagnostics.AddAttributeError(path.Root("id"), "Access Workflow Not Found", fmt.Sprintf("No access workflow with ID %q exists.", config.ID.ValueString()))
+			return
+		} else if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to read Access Workflow",
+				err.Error(),
+			)
+			return
+		}
+		workflow = res.Msg.Workflow
+	} else {
+		workflows, err := listAccessWorkflows(ctx, &r.entities, r.client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to list Access Workflows",
+				err.Error(),
+			)
+			return
+		}
+
+		for _, w := range workflows {
+			if w.Name != config.Name.ValueString() {
+				continue
+			}
+			if workflow != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("name"), "Multiple Access Workflows Found", fmt.Sprintf("More than one access workflow is named %q (%s and %s). Look the workflow up by id instead.", w.Name, workflow.Id, w.Id))
+				return
+			}
+			workflow = w
+		}
+
+		if workflow == nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Access Workflow Not Found", fmt.Sprintf("No access workflow named %q exists.", config.Name.ValueString()))
+			return
+		}
+	}
+
+	state := accessWorkflowDatasourceModelFromAPI(workflow)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// listAccessWorkflows returns every access workflow in the deployment.
+func listAccessWorkflows(ctx context.Context, entities *entity.Client, client configv1alpha1connect.AccessWorkflowServiceClient) ([]*configv1alpha1.AccessWorkflow, error) {
+	all, err := entities.All(ctx, entity.ListInput{Type: workflowEntityType})
+	if err != nil {
+		return nil, err
+	}
+
+	var workflows []*configv1alpha1.AccessWorkflow
+	for _, e := range all {
+		res, err := client.GetAccessWorkflow(ctx, connect.NewRequest(&configv1alpha1.GetAccessWorkflowRequest{
+			Id: e.Eid.Id,
+		}))
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			// the workflow was deleted after it was listed.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		workflows = append(workflows, res.Msg.Workflow)
+	}
+
+	return workflows, nil
+}
+
+func accessWorkflowDatasourceModelFromAPI(w *configv1alpha1.AccessWorkflow) AccessWorkflowDatasourceModel {
+	model := AccessWorkflowDatasourceModel{
+		ID:                        types.StringValue(w.Id),
+		Name:                      types.StringValue(w.Name),
+		AccessDuration:            durationSeconds(w.AccessDuration),
+		TryExtendAfter:            durationSeconds(w.TryExtendAfter),
+		Priority:                  types.Int64Value(int64(w.Priority)),
+		ActivationExpiry:          durationSeconds(w.ActivationExpiry),
+		RequestedToApprovedExpiry: durationSeconds(w.RequestToApproveExpiry),
+		RequestedToActivateExpiry: durationSeconds(w.RequestToActiveExpiry),
+		DefaultDuration:           durationSeconds(w.DefaultDuration),
+		Validation:                validationsFromAPI(w.Validation),
+		ExtensionConditions:       extensionConditionsDatasourceModelFromAPI(w.ExtensionConditions),
+		ApprovalSteps:             approvalStepsFromAPI(w.ApprovalSteps),
+	}
+
+	return model
+}
+
+// durationSeconds converts an optional API duration to a number of seconds, or null if it isn't set.
+func durationSeconds(d *durationpb.Duration) types.Int64 {
+	if d == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(d.Seconds)
+}
+
+func validationsFromAPI(v *configv1alpha1.ValidationConfig) *Validations {
+	if v == nil {
+		return nil
+	}
+
+	var regexValidations []RegexValidation
+	for _, r := range v.ReasonRegex {
+		regexValidations = append(regexValidations, RegexValidation{
+			RegexPattern: types.StringValue(r.RegexPattern),
+			ErrorMessage: types.StringValue(r.ErrorMessage),
+		})
+	}
+
+	return &Validations{
+		HasReason:     types.BoolValue(v.HasReason),
+		ReasonRegex:   regexValidations,
+		HasJiraTicket: types.BoolValue(v.HasJiraTicket),
+	}
+}
+
+func extensionConditionsDatasourceModelFromAPI(c *accessv1alpha1.ExtensionConditions) *ExtensionConditionsDatasourceModel {
+	if c == nil {
+		return nil
+	}
+
+	return &ExtensionConditionsDatasourceModel{
+		ExtensionDuration: durationSeconds(c.ExtensionDurationSeconds),
+		MaxExtensions:     types.Int64Value(int64(c.MaximumNumberOfExtensions)),
+	}
+}
+
+func approvalStepsFromAPI(steps []*configv1alpha1.ApprovalStep) []ApprovalStep {
+	var out []ApprovalStep
+	for _, step := range steps {
+		out = append(out, ApprovalStep{
+			Name: types.StringValue(step.Name),
+			When: cedarpolicy.NewExpressionValue(step.When),
+		})
+	}
+	return out
+}
+
+// accessWorkflowDatasourceAttributes returns the computed attributes describing an access workflow.
+func accessWorkflowDatasourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The internal approval workflow ID",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the workflow.",
+			Computed:            true,
+		},
+		"access_duration_seconds": schema.Int64Attribute{
+			MarkdownDescription: "The maximum allowable duration for the access workflow",
+			Computed:            true,
+		},
+		"try_extend_after_seconds": schema.Int64Attribute{
+			MarkdownDescription: "The amount of time after access is activated that extending access can be attempted.",
+			Computed:            true,
+		},
+		"priority": schema.Int64Attribute{
+			MarkdownDescription: "The priority that governs whether the policy will be used.",
+			Computed:            true,
+		},
+		"activation_expiry": schema.Int64Attribute{
+			MarkdownDescription: "The amount of time after access is approved to be activated before the request will be expired",
+			Computed:            true,
+		},
+		"requested_to_approved_expiry": schema.Int64Attribute{
+			MarkdownDescription: "The amount of time after a request is made and approved before the request will be expired",
+			Computed:            true,
+		},
+		"requested_to_activate_expiry": schema.Int64Attribute{
+			MarkdownDescription: "The amount of time after a request is made and activated before the request will be expired",
+			Computed:            true,
+		},
+		"default_duration_seconds": schema.Int64Attribute{
+			MarkdownDescription: "The default duration of the access workflow",
+			Computed:            true,
+		},
+		"validation": schema.SingleNestedAttribute{
+			MarkdownDescription: "Validation requirements set with this workflow",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"has_reason": schema.BoolAttribute{
+					MarkdownDescription: "Whether a reason is required for this workflow",
+					Computed:            true,
+				},
+				"reason_regex": schema.ListNestedAttribute{
+					MarkdownDescription: "Regex validation requirements for the reason",
+					Computed:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"regex_pattern": schema.StringAttribute{
+								MarkdownDescription: "The regex pattern that the reason should match on.",
+								Computed:            true,
+							},
+							"error_message": schema.StringAttribute{
+								MarkdownDescription: "The custom error message to show if the reason doesn't match the regex pattern.",
+								Computed:            true,
+							},
+						},
+					},
+				},
+				"has_jira_ticket": schema.BoolAttribute{
+					MarkdownDescription: "Whether a jira ticket is required for this workflow",
+					Computed:            true,
+				},
+			},
+		},
+		"extension_conditions": schema.SingleNestedAttribute{
+			MarkdownDescription: "Configuration for extending access",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"maximum_number_of_extensions": schema.Int64Attribute{
+					MarkdownDescription: "The maximum number of allowed extensions.",
+					Computed:            true,
+				},
+				"extension_duration_seconds": schema.Int64Attribute{
+					MarkdownDescription: "The duration of each extension.",
+					Computed:            true,
+				},
+			},
+		},
+		"approval_steps": schema.ListNestedAttribute{
+			MarkdownDescription: "The requirements for grant approval.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the approval step.",
+						Computed:            true,
+					},
+					"when": schema.StringAttribute{
+						MarkdownDescription: "The Cedar when expression to evaluate a review for a match.",
+						Computed:            true,
+						CustomType:          cedarpolicy.ExpressionType{},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,161 @@
+package access_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const accessWorkflowDatasourceWorkflows = `
+resource "commonfate_access_workflow" "prod" {
+  name                    = "prod-admin"
+  access_duration_seconds = 3600
+  priority                = 10
+
+  validation = {
+    has_reason = true
+  }
+
+  approval_steps = [
+    {
+      name = "manager"
+      when = "principal in Group::\"managers\""
+    },
+  ]
+}
+
+resource "commonfate_access_workflow" "prod_readonly" {
+  name                    = "prod-readonly"
+  access_duration_seconds = 7200
+  priority                = 1
+}
+
+resource "commonfate_access_workflow" "dev" {
+  name                    = "dev"
+  access_duration_seconds = 600
+  priority                = 1
+}
+`
+
+func TestAccAccessWorkflowDatasource(t *testing.T) {
+	s := acctest.NewServer(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(s) + accessWorkflowDatasourceWorkflows + `
+data "commonfate_access_workflow" "by_id" {
+  id = commonfate_access_workflow.prod.id
+}
+
+data "commonfate_access_workflow" "by_name" {
+  name = "prod-admin"
+
+  depends_on = [
+    commonfate_access_workflow.prod,
+    commonfate_access_workflow.prod_readonly,
+    commonfate_access_workflow.dev,
+  ]
+}
+
+data "commonfate_access_workflows" "all" {
+  depends_on = [
+    commonfate_access_workflow.prod,
+    commonfate_access_workflow.prod_readonly,
+    commonfate_access_workflow.dev,
+  ]
+}
+
+data "commonfate_access_workflows" "prod" {
+  name_prefix = "prod-"
+
+  depends_on = [
+    commonfate_access_workflow.prod,
+    commonfate_access_workflow.prod_readonly,
+    commonfate_access_workflow.dev,
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.commonfate_access_workflow.by_id", "name", "prod-admin"),
+					resource.TestCheckResourceAttr("data.commonfate_access_workflow.by_id", "access_duration_seconds", "3600"),
+					resource.TestCheckResourceAttr("data.commonfate_access_workflow.by_id", "priority", "10"),
+					resource.TestCheckResourceAttr("data.commonfate_access_workflow.by_id", "validation.has_reason", "true"),
+					resource.TestCheckResourceAttr("data.commonfate_access_workflow.by_id", "approval_steps.0.name", "manager"),
+					resource.TestCheckResourceAttrPair("data.commonfate_access_workflow.by_name", "id", "commonfate_access_workflow.prod", "id"),
+					resource.TestCheckResourceAttr("data.commonfate_access_workflow.by_name", "approval_steps.0.when", `principal in Group::"managers"`),
+					resource.TestCheckResourceAttr("data.commonfate_access_workflows.all", "workflows.#", "3"),
+					resource.TestCheckResourceAttr("data.commonfate_access_workflows.all", "workflows.0.name", "dev"),
+					resource.TestCheckResourceAttr("data.commonfate_access_workflows.prod", "workflows.#", "2"),
+					resource.TestCheckResourceAttr("data.commonfate_access_workflows.prod", "workflows.0.name", "prod-admin"),
+					resource.TestCheckResourceAttr("data.commonfate_access_workflows.prod", "workflows.1.name", "prod-readonly"),
+					resource.TestCheckResourceAttr("data.commonfate_access_workflows.prod", "workflows.1.access_duration_seconds", "7200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessWorkflowDatasource_Errors(t *testing.T) {
+	s := acctest.NewServer(t)
+
+	tests := []struct {
+		name        string
+		config      string
+		expectError *regexp.Regexp
+	}{
+		{
+			name: "neither id nor name",
+			config: `
+data "commonfate_access_workflow" "test" {}
+`,
+			expectError: regexp.MustCompile(`Missing Attribute Configuration`),
+		},
+		{
+			name: "both id and name",
+			config: `
+data "commonfate_access_workflow" "test" {
+  id   = "wf_123"
+  name = "test"
+}
+`,
+			expectError: regexp.MustCompile(`Invalid Attribute Combination`),
+		},
+		{
+			name: "unknown id",
+			config: `
+data "commonfate_access_workflow" "test" {
+  id = "wf_missing"
+}
+`,
+			expectError: regexp.MustCompile(`Access Workflow Not Found`),
+		},
+		{
+			name: "unknown name",
+			config: `
+data "commonfate_access_workflow" "test" {
+  name = "missing"
+}
+`,
+			expectError: regexp.MustCompile(`Access Workflow Not Found`),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			resource.ParallelTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config:      acctest.ProviderConfig(s) + tt.config,
+						ExpectError: tt.expectError,
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	configv1alpha1connect "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1/configv1alpha1connect"
+	accessworkflow_handler "github.com/common-fate/sdk/service/control/config/accessworkflow"
+	"github.com/common-fate/sdk/service/entity"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type AccessWorkflowsDatasourceModel struct {
+	NamePrefix types.String                    `tfsdk:"name_prefix"`
+	Workflows  []AccessWorkflowDatasourceModel `tfsdk:"workflows"`
+}
+
+// AccessWorkflowsDatasource lists the access workflows in the deployment.
+type AccessWorkflowsDatasource struct {
+	client   configv1alpha1connect.AccessWorkflowServiceClient
+	entities entity.Client
+}
+
+var _ datasource.DataSource = &AccessWorkflowsDatasource{}
+
+// Metadata returns the data source type name.
+func (r *AccessWorkflowsDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_workflows"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *AccessWorkflowsDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	cfg := providerData.Config
+
+	r.client = accessworkflow_handler.NewFromConfig(cfg)
+	r.entities = entity.NewFromConfig(cfg)
+}
+
+func (r *AccessWorkflowsDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Access Workflows in the deployment, optionally filtered by a name prefix.",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "If set, only workflows with names starting with this prefix are returned.",
+				Optional:            true,
+			},
+			"workflows": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching workflows, ordered by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: accessWorkflowDatasourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *AccessWorkflowsDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HTTP Client",
+			"Expected configured HTTP client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var state AccessWorkflowsDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflows, err := listAccessWorkflows(ctx, &r.entities, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list Access Workflows",
+			err.Error(),
+		)
+		return
+	}
+
+	sort.SliceStable(workflows, func(i, j int) bool {
+		if workflows[i].Name != workflows[j].Name {
+			return workflows[i].Name < workflows[j].Name
+		}
+		return workflows[i].Id < workflows[j].Id
+	})
+
+	state.Workflows = []AccessWorkflowDatasourceModel{}
+	for _, w := range workflows {
+		if !strings.HasPrefix(w.Name, state.NamePrefix.ValueString()) {
+			continue
+		}
+		state.Workflows = append(state.Workflows, accessWorkflowDatasourceModelFromAPI(w))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
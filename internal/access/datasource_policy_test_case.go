@@ -0,0 +1,217 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdkeid "github.com/common-fate/sdk/eid"
+	authzv1alpha1 "github.com/common-fate/sdk/gen/commonfate/authz/v1alpha1"
+	"github.com/common-fate/sdk/service/authz"
+	"github.com/common-fate/sdk/service/entity"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/common-fate/terraform-provider-commonfate/pkg/eid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	decisionAllow = "allow"
+	decisionDeny  = "deny"
+)
+
+type PolicyTestModel struct {
+	Principal       eid.EID                `tfsdk:"principal"`
+	Action          eid.EID                `tfsdk:"action"`
+	Resource        eid.EID                `tfsdk:"resource"`
+	OverlayChildren []PolicyTestChildModel `tfsdk:"overlay_children"`
+	Expect          types.String           `tfsdk:"expect"`
+	Decision        types.String           `tfsdk:"decision"`
+	Reasons         []types.String         `tfsdk:"reasons"`
+}
+
+type PolicyTestChildModel struct {
+	Parent eid.EID `tfsdk:"parent"`
+	Child  eid.EID `tfsdk:"child"`
+}
+
+// PolicyTestDatasource asks the authz service for a decision on a request and fails
+// if it isn't the expected one, so that policy changes can be checked in the plan.
+type PolicyTestDatasource struct {
+	client *authz.Client
+}
+
+var _ datasource.DataSource = &PolicyTestDatasource{}
+
+// Metadata returns the data source type name.
+func (r *PolicyTestDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_test"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *PolicyTestDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	client := authz.NewFromConfig(providerData.Config)
+	r.client = &client
+}
+
+func (r *PolicyTestDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Evaluates an authorization request against the deployed policies and fails if the decision isn't the one in `expect`. " +
+			"Use it to assert that a principal can, or can't, perform an action on a resource, so that a policy change which breaks access fails the plan. " +
+			"The request is evaluated against the policysets stored in Common Fate at the time of the read, so add a `depends_on` on the `commonfate_policyset` resources being tested to evaluate it after they have been applied.\n\n" +
+			"The authz API doesn't accept a request context, so the request is evaluated with an empty `context` and policies which read it, such as conditions on the requested duration or reason, can't be tested. " +
+			"Use `overlay_children` to evaluate the request with relationships which haven't been synced, such as a user being a member of a group.",
+		Attributes: map[string]schema.Attribute{
+			"principal": schema.SingleNestedAttribute{
+				MarkdownDescription: "The principal making the request, e.g. `{ type = \"CF::User\", id = \"usr_123\" }`.",
+				Required:            true,
+				Attributes:          eid.DataSourceEIDAttrs,
+			},
+			"action": schema.SingleNestedAttribute{
+				MarkdownDescription: "The action being requested, e.g. `{ type = \"Access::Action\", id = \"Request\" }`.",
+				Required:            true,
+				Attributes:          eid.DataSourceEIDAttrs,
+			},
+			"resource": schema.SingleNestedAttribute{
+				MarkdownDescription: "The resource the action is performed on.",
+				Required:            true,
+				Attributes:          eid.DataSourceEIDAttrs,
+			},
+			"overlay_children": schema.ListNestedAttribute{
+				MarkdownDescription: "Parent/child relationships to add to the synced entities for this request only, e.g. to test what a user could request if they were added to a group.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"parent": schema.SingleNestedAttribute{
+							MarkdownDescription: "The parent entity, e.g. `{ type = \"CF::Group\", id = \"engineering\" }`.",
+							Required:            true,
+							Attributes:          eid.DataSourceEIDAttrs,
+						},
+						"child": schema.SingleNestedAttribute{
+							MarkdownDescription: "The child entity, e.g. `{ type = \"CF::User\", id = \"usr_123\" }`.",
+							Required:            true,
+							Attributes:          eid.DataSourceEIDAttrs,
+						},
+					},
+				},
+			},
+			"expect": schema.StringAttribute{
+				MarkdownDescription: "The expected decision, either `allow` or `deny`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(decisionAllow, decisionDeny),
+				},
+			},
+			"decision": schema.StringAttribute{
+				MarkdownDescription: "The decision made by the authz service, either `allow` or `deny`.",
+				Computed:            true,
+			},
+			"reasons": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the policies which determined the decision.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *PolicyTestDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HTTP Client",
+			"Expected configured HTTP client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var state PolicyTestModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var children []entity.ChildRelation
+	for _, c := range state.OverlayChildren {
+		children = append(children, entity.ChildRelation{Parent: sdkEID(c.Parent), Child: sdkEID(c.Child)})
+	}
+
+	res, err := r.client.BatchAuthorize(ctx, authz.BatchAuthorizeInput{
+		Requests: map[string]authz.Request{
+			"test": {
+				Principal:       sdkEID(state.Principal),
+				Action:          sdkEID(state.Action),
+				Resource:        sdkEID(state.Resource),
+				OverlayChildren: children,
+			},
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to evaluate Policy Test",
+			err.Error(),
+		)
+		return
+	}
+	if len(res.Evaluations) != 1 {
+		resp.Diagnostics.AddError(
+			"Failed to evaluate Policy Test",
+			fmt.Sprintf("Expected 1 evaluation from the authz service, got %d.", len(res.Evaluations)),
+		)
+		return
+	}
+	eval := res.Evaluations[0]
+
+	state.Decision = types.StringValue(decisionDeny)
+	if eval.Decision == authzv1alpha1.Decision_DECISION_ALLOW {
+		state.Decision = types.StringValue(decisionAllow)
+	}
+
+	state.Reasons = []types.String{}
+	for _, reason := range eval.GetDiagnostics().GetReason() {
+		state.Reasons = append(state.Reasons, types.StringValue(reason))
+	}
+
+	if !state.Decision.Equal(state.Expect) {
+		detail := fmt.Sprintf("Expected the decision for %s performing %s on %s to be %s, but it was %s.",
+			state.Principal.String(), state.Action.String(), state.Resource.String(), state.Expect.ValueString(), state.Decision.ValueString())
+		if reasons := eval.GetDiagnostics().GetReason(); len(reasons) > 0 {
+			detail += fmt.Sprintf(" Determining policies: %s.", strings.Join(reasons, ", "))
+		}
+		if errs := eval.GetDiagnostics().GetErrors(); len(errs) > 0 {
+			detail += fmt.Sprintf(" Errors during evaluation: %s.", strings.Join(errs, "; "))
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expect"),
+			"Policy Test Failed",
+			detail,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func sdkEID(e eid.EID) sdkeid.EID {
+	return sdkeid.New(e.Type.ValueString(), e.ID.ValueString())
+}
@@ -0,0 +1,122 @@
+package access_test
+
+import (
+	"regexp"
+	"testing"
+
+	entityv1alpha1 "github.com/common-fate/sdk/gen/commonfate/entity/v1alpha1"
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const policyTestPolicySet = `
+resource "commonfate_policyset" "test" {
+  id   = "engineering"
+  text = <<EOT
+@id("engineers-request-dev")
+permit (
+  principal in CF::Group::"engineering",
+  action == Access::Action::"Request",
+  resource in AWS::OrgUnit::"ou-dev"
+);
+EOT
+}
+`
+
+func TestAccPolicyTestDatasource(t *testing.T) {
+	s := acctest.NewServer(t)
+
+	engineering := &entityv1alpha1.EID{Type: "CF::Group", Id: "engineering"}
+	dev := &entityv1alpha1.EID{Type: "AWS::OrgUnit", Id: "ou-dev"}
+	s.PutEntity(&entityv1alpha1.Entity{Eid: engineering})
+	s.PutEntity(&entityv1alpha1.Entity{Eid: dev})
+	s.PutEntity(&entityv1alpha1.Entity{Eid: &entityv1alpha1.EID{Type: "CF::User", Id: "usr_alice"}}, engineering)
+	s.PutEntity(&entityv1alpha1.Entity{Eid: &entityv1alpha1.EID{Type: "CF::User", Id: "usr_bob"}})
+	s.PutEntity(&entityv1alpha1.Entity{Eid: &entityv1alpha1.EID{Type: "AWS::Account", Id: "123456789012"}}, dev)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(s) + policyTestPolicySet + `
+data "commonfate_policy_test" "allowed" {
+  principal = { type = "CF::User", id = "usr_alice" }
+  action    = { type = "Access::Action", id = "Request" }
+  resource  = { type = "AWS::Account", id = "123456789012" }
+  expect    = "allow"
+
+  depends_on = [commonfate_policyset.test]
+}
+
+data "commonfate_policy_test" "denied" {
+  principal = { type = "CF::User", id = "usr_bob" }
+  action    = { type = "Access::Action", id = "Request" }
+  resource  = { type = "AWS::Account", id = "123456789012" }
+  expect    = "deny"
+
+  depends_on = [commonfate_policyset.test]
+}
+
+// bob would be allowed if they were added to the engineering group.
+data "commonfate_policy_test" "overlay" {
+  principal = { type = "CF::User", id = "usr_bob" }
+  action    = { type = "Access::Action", id = "Request" }
+  resource  = { type = "AWS::Account", id = "123456789012" }
+  expect    = "allow"
+
+  overlay_children = [
+    {
+      parent = { type = "CF::Group", id = "engineering" }
+      child  = { type = "CF::User", id = "usr_bob" }
+    },
+  ]
+
+  depends_on = [commonfate_policyset.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.commonfate_policy_test.overlay", "decision", "allow"),
+					resource.TestCheckResourceAttr("data.commonfate_policy_test.allowed", "decision", "allow"),
+					resource.TestCheckResourceAttr("data.commonfate_policy_test.allowed", "reasons.#", "1"),
+					resource.TestCheckResourceAttr("data.commonfate_policy_test.allowed", "reasons.0", "engineers-request-dev"),
+					resource.TestCheckResourceAttr("data.commonfate_policy_test.denied", "decision", "deny"),
+					resource.TestCheckResourceAttr("data.commonfate_policy_test.denied", "reasons.#", "0"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig(s) + policyTestPolicySet + `
+data "commonfate_policy_test" "failing" {
+  principal = { type = "CF::User", id = "usr_bob" }
+  action    = { type = "Access::Action", id = "Request" }
+  resource  = { type = "AWS::Account", id = "123456789012" }
+  expect    = "allow"
+
+  depends_on = [commonfate_policyset.test]
+}
+`,
+				ExpectError: regexp.MustCompile(`(?s)Policy Test Failed.*CF::User::"usr_bob".*to be allow, but it\s+was deny`),
+			},
+		},
+	})
+}
+
+func TestAccPolicyTestDatasource_InvalidExpect(t *testing.T) {
+	s := acctest.NewServer(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(s) + `
+data "commonfate_policy_test" "invalid" {
+  principal = { type = "CF::User", id = "usr_alice" }
+  action    = { type = "Access::Action", id = "Request" }
+  resource  = { type = "AWS::Account", id = "123456789012" }
+  expect    = "allowed"
+}
+`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
@@ -0,0 +1,134 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/common-fate/sdk/service/authz/policyset"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PolicySetsDatasourceModel struct {
+	IDPrefix   types.String               `tfsdk:"id_prefix"`
+	PolicySets []PolicySetDatasourceModel `tfsdk:"policysets"`
+}
+
+type PolicySetDatasourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Text types.String `tfsdk:"text"`
+}
+
+// PolicySetsDatasource lists the policysets in the deployment, so that existing
+// policysets can be imported without copying their IDs and text by hand.
+type PolicySetsDatasource struct {
+	client *policyset.Client
+}
+
+var _ datasource.DataSource = &PolicySetsDatasource{}
+
+// Metadata returns the data source type name.
+func (r *PolicySetsDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policysets"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *PolicySetsDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	client := policyset.NewFromConfig(providerData.Config)
+	r.client = &client
+}
+
+func (r *PolicySetsDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the policysets in the deployment along with their Cedar text, optionally filtered by an ID prefix. " +
+			"The IDs can be used in `import` blocks to bring every existing policyset under management at once.",
+		Attributes: map[string]schema.Attribute{
+			"id_prefix": schema.StringAttribute{
+				MarkdownDescription: "If set, only policysets with IDs starting with this prefix are returned.",
+				Optional:            true,
+			},
+			"policysets": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching policysets, ordered by ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the policyset.",
+							Computed:            true,
+						},
+						"text": schema.StringAttribute{
+							MarkdownDescription: "The Cedar text of the policyset.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *PolicySetsDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HTTP Client",
+			"Expected configured HTTP client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var state PolicySetsDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var all []policyset.PolicySet
+	err := r.client.ListPolicySetsRequest(policyset.ListInput{}).Pages(ctx, func(out policyset.ListOutput) error {
+		all = append(all, out.PolicySets...)
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list PolicySets",
+			err.Error(),
+		)
+		return
+	}
+
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].ID < all[j].ID
+	})
+
+	state.PolicySets = []PolicySetDatasourceModel{}
+	for _, ps := range all {
+		if !strings.HasPrefix(ps.ID, state.IDPrefix.ValueString()) {
+			continue
+		}
+		state.PolicySets = append(state.PolicySets, PolicySetDatasourceModel{
+			ID:   types.StringValue(ps.ID),
+			Text: types.StringValue(ps.Text),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,41 @@
+package access_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPolicySetsDatasource(t *testing.T) {
+	s := acctest.NewServer(t)
+	// created outside of Terraform, as when adopting an existing deployment.
+	s.PutPolicySet("prod-admins", `permit(principal in CF::Group::"admins", action, resource);`)
+	s.PutPolicySet("prod-readonly", `permit(principal, action == Access::Action::"Request", resource);`)
+	s.PutPolicySet("dev", `permit(principal, action, resource);`)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(s) + `
+data "commonfate_policysets" "all" {}
+
+data "commonfate_policysets" "prod" {
+  id_prefix = "prod-"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.commonfate_policysets.all", "policysets.#", "3"),
+					resource.TestCheckResourceAttr("data.commonfate_policysets.all", "policysets.0.id", "dev"),
+					resource.TestCheckResourceAttr("data.commonfate_policysets.all", "policysets.0.text", "permit(principal, action, resource);"),
+					resource.TestCheckResourceAttr("data.commonfate_policysets.all", "policysets.1.id", "prod-admins"),
+					resource.TestCheckResourceAttr("data.commonfate_policysets.all", "policysets.2.id", "prod-readonly"),
+					resource.TestCheckResourceAttr("data.commonfate_policysets.prod", "policysets.#", "2"),
+					resource.TestCheckResourceAttr("data.commonfate_policysets.prod", "policysets.0.id", "prod-admins"),
+					resource.TestCheckResourceAttr("data.commonfate_policysets.prod", "policysets.1.id", "prod-readonly"),
+				),
+			},
+		},
+	})
+}
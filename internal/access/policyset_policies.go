@@ -0,0 +1,244 @@
+package access
+
+import (
+	"fmt"
+
+	"github.com/cedar-policy/cedar-go/types"
+	"github.com/common-fate/terraform-provider-commonfate/internal/cedarpolicy"
+	"github.com/common-fate/terraform-provider-commonfate/pkg/eid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PolicyStatement is a Cedar policy written as HCL, which is rendered to Cedar text for the policyset.
+type PolicyStatement struct {
+	Effect      tftypes.String            `tfsdk:"effect"`
+	Annotations map[string]tftypes.String `tfsdk:"annotations"`
+	Principal   *PolicyScope              `tfsdk:"principal"`
+	Action      *PolicyActionScope        `tfsdk:"action"`
+	Resource    *PolicyScope              `tfsdk:"resource"`
+	When        cedarpolicy.Expression    `tfsdk:"when"`
+	Unless      cedarpolicy.Expression    `tfsdk:"unless"`
+}
+
+type PolicyScope struct {
+	Eq *eid.EID       `tfsdk:"eq"`
+	In *eid.EID       `tfsdk:"in"`
+	Is tftypes.String `tfsdk:"is"`
+}
+
+type PolicyActionScope struct {
+	Eq *eid.EID  `tfsdk:"eq"`
+	In []eid.EID `tfsdk:"in"`
+}
+
+func policyScopeAttribute(name string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("The %[1]ss the policy applies to. If it isn't set, the policy applies to any %[1]s.", name),
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"eq": schema.SingleNestedAttribute{
+				MarkdownDescription: fmt.Sprintf("Matches only this %s. Can't be combined with `in` or `is`.", name),
+				Optional:            true,
+				Attributes:          eid.EIDAttrs,
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("in"), path.MatchRelative().AtParent().AtName("is")),
+				},
+			},
+			"in": schema.SingleNestedAttribute{
+				MarkdownDescription: fmt.Sprintf("Matches any %s which is this entity or one of its descendants.", name),
+				Optional:            true,
+				Attributes:          eid.EIDAttrs,
+			},
+			"is": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Matches any %s of this entity type, e.g. `CF::User`.", name),
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func policyStatementsAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "The policies in the policyset, written as attributes rather than Cedar text. The policies are rendered to Cedar and stored in `text`. Exactly one of `text` or `policies` must be set.",
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"effect": schema.StringAttribute{
+					MarkdownDescription: "Whether the policy permits or forbids access. Either `permit` or `forbid`.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(cedarpolicy.EffectPermit, cedarpolicy.EffectForbid),
+					},
+				},
+				"annotations": schema.MapAttribute{
+					MarkdownDescription: "Annotations to add to the policy, such as `id`.",
+					Optional:            true,
+					ElementType:         tftypes.StringType,
+				},
+				"principal": policyScopeAttribute("principal"),
+				"action": schema.SingleNestedAttribute{
+					MarkdownDescription: "The actions the policy applies to. If it isn't set, the policy applies to any action.",
+					Optional:            true,
+					Attributes: map[string]schema.Attribute{
+						"eq": schema.SingleNestedAttribute{
+							MarkdownDescription: "Matches only this action, e.g. `{ type = \"Access::Action\", id = \"Request\" }`. Can't be combined with `in`.",
+							Optional:            true,
+							Attributes:          eid.EIDAttrs,
+							Validators: []validator.Object{
+								objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("in")),
+							},
+						},
+						"in": schema.ListNestedAttribute{
+							MarkdownDescription: "Matches any of these actions.",
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: eid.EIDAttrs,
+							},
+						},
+					},
+				},
+				"resource": policyScopeAttribute("resource"),
+				"when": schema.StringAttribute{
+					MarkdownDescription: "A Cedar expression which must be true for the policy to apply, e.g. `resource.tags.contains(\"prod\")`.",
+					Optional:            true,
+					CustomType:          cedarpolicy.ExpressionType{},
+				},
+				"unless": schema.StringAttribute{
+					MarkdownDescription: "A Cedar expression which must be false for the policy to apply.",
+					Optional:            true,
+					CustomType:          cedarpolicy.ExpressionType{},
+				},
+			},
+		},
+	}
+}
+
+// toCedar converts the policy for rendering. It returns false if any of the policy's attributes are unknown.
+func (p PolicyStatement) toCedar() (cedarpolicy.Policy, bool) {
+	known := !p.Effect.IsUnknown() && !p.When.IsUnknown() && !p.Unless.IsUnknown()
+
+	out := cedarpolicy.Policy{
+		Effect: p.Effect.ValueString(),
+		When:   p.When.ValueString(),
+		Unless: p.Unless.ValueString(),
+	}
+
+	if p.Annotations != nil {
+		out.Annotations = map[string]string{}
+		for k, v := range p.Annotations {
+			known = known && !v.IsUnknown()
+			out.Annotations[k] = v.ValueString()
+		}
+	}
+
+	var ok bool
+	out.Principal, ok = p.Principal.toCedar()
+	known = known && ok
+	out.Resource, ok = p.Resource.toCedar()
+	known = known && ok
+
+	if p.Action != nil {
+		out.Action.Eq, ok = entityUID(p.Action.Eq)
+		known = known && ok
+		for i := range p.Action.In {
+			uid, ok := entityUID(&p.Action.In[i])
+			known = known && ok
+			out.Action.In = append(out.Action.In, *uid)
+		}
+	}
+
+	return out, known
+}
+
+func (s *PolicyScope) toCedar() (cedarpolicy.Scope, bool) {
+	if s == nil {
+		return cedarpolicy.Scope{}, true
+	}
+
+	eq, eqKnown := entityUID(s.Eq)
+	in, inKnown := entityUID(s.In)
+	return cedarpolicy.Scope{
+		Eq: eq,
+		In: in,
+		Is: types.EntityType(s.Is.ValueString()),
+	}, eqKnown && inKnown && !s.Is.IsUnknown()
+}
+
+func entityUID(e *eid.EID) (*types.EntityUID, bool) {
+	if e == nil {
+		return nil, true
+	}
+	uid := types.NewEntityUID(types.EntityType(e.Type.ValueString()), types.String(e.ID.ValueString()))
+	return &uid, !e.Type.IsUnknown() && !e.ID.IsUnknown()
+}
+
+// policyStatementsFromCedar converts parsed policies back into their HCL form.
+func policyStatementsFromCedar(policies []cedarpolicy.Policy) []PolicyStatement {
+	out := make([]PolicyStatement, len(policies))
+	for i, p := range policies {
+		s := PolicyStatement{
+			Effect:    tftypes.StringValue(p.Effect),
+			Principal: policyScopeFromCedar(p.Principal),
+			Resource:  policyScopeFromCedar(p.Resource),
+			When:      optionalExpression(p.When),
+			Unless:    optionalExpression(p.Unless),
+		}
+
+		if p.Annotations != nil {
+			s.Annotations = map[string]tftypes.String{}
+			for k, v := range p.Annotations {
+				s.Annotations[k] = tftypes.StringValue(v)
+			}
+		}
+
+		if p.Action.Eq != nil || p.Action.In != nil {
+			s.Action = &PolicyActionScope{Eq: eidFromCedar(p.Action.Eq)}
+			for _, uid := range p.Action.In {
+				s.Action.In = append(s.Action.In, *eidFromCedar(&uid))
+			}
+		}
+
+		out[i] = s
+	}
+	return out
+}
+
+func policyScopeFromCedar(s cedarpolicy.Scope) *PolicyScope {
+	if s.Eq == nil && s.In == nil && s.Is == "" {
+		return nil
+	}
+	return &PolicyScope{
+		Eq: eidFromCedar(s.Eq),
+		In: eidFromCedar(s.In),
+		Is: optionalString(string(s.Is)),
+	}
+}
+
+func eidFromCedar(uid *types.EntityUID) *eid.EID {
+	if uid == nil {
+		return nil
+	}
+	return &eid.EID{
+		Type: tftypes.StringValue(string(uid.Type)),
+		ID:   tftypes.StringValue(string(uid.ID)),
+	}
+}
+
+func optionalExpression(s string) cedarpolicy.Expression {
+	if s == "" {
+		return cedarpolicy.NewExpressionNull()
+	}
+	return cedarpolicy.NewExpressionValue(s)
+}
+
+func optionalString(s string) tftypes.String {
+	if s == "" {
+		return tftypes.StringNull()
+	}
+	return tftypes.StringValue(s)
+}
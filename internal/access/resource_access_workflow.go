@@ -7,18 +7,23 @@ import (
 
 	"connectrpc.com/connect"
 
-	config_client "github.com/common-fate/sdk/config"
 	accessv1alpha1 "github.com/common-fate/sdk/gen/commonfate/access/v1alpha1"
 	configv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1"
 	configv1alpha1connect "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1/configv1alpha1connect"
 	accessworkflow_handler "github.com/common-fate/sdk/service/control/config/accessworkflow"
+	"github.com/common-fate/terraform-provider-commonfate/internal/cedarpolicy"
+	"github.com/common-fate/terraform-provider-commonfate/internal/duration"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
@@ -35,31 +40,34 @@ type RegexValidation struct {
 }
 
 type ExtensionConditions struct {
-	MaxExtensions     types.Int64 `tfsdk:"maximum_number_of_extensions"`
-	ExtensionDuration types.Int64 `tfsdk:"extension_duration_seconds"`
+	MaxExtensions     types.Int64    `tfsdk:"maximum_number_of_extensions"`
+	ExtensionDuration duration.Value `tfsdk:"extension_duration_seconds"`
 }
 type ApprovalStep struct {
-	Name types.String `tfsdk:"name"`
-	When types.String `tfsdk:"when"`
+	Name types.String           `tfsdk:"name"`
+	When cedarpolicy.Expression `tfsdk:"when"`
 }
 type AccessWorkflowModel struct {
 	ID                        types.String         `tfsdk:"id"`
 	Name                      types.String         `tfsdk:"name"`
-	AccessDuration            types.Int64          `tfsdk:"access_duration_seconds"`
-	TryExtendAfter            types.Int64          `tfsdk:"try_extend_after_seconds"`
+	AccessDuration            duration.Value       `tfsdk:"access_duration_seconds"`
+	TryExtendAfter            duration.Value       `tfsdk:"try_extend_after_seconds"`
 	Priority                  types.Int64          `tfsdk:"priority"`
-	ActivationExpiry          types.Int64          `tfsdk:"activation_expiry"`
-	RequestedToApprovedExpiry types.Int64          `tfsdk:"requested_to_approved_expiry"`
-	RequestedToActivateExpiry types.Int64          `tfsdk:"requested_to_activate_expiry"`
-	DefaultDuration           types.Int64          `tfsdk:"default_duration_seconds"`
+	ActivationExpiry          duration.Value       `tfsdk:"activation_expiry"`
+	RequestedToApprovedExpiry duration.Value       `tfsdk:"requested_to_approved_expiry"`
+	RequestedToActivateExpiry duration.Value       `tfsdk:"requested_to_activate_expiry"`
+	DefaultDuration           duration.Value       `tfsdk:"default_duration_seconds"`
 	Validation                *Validations         `tfsdk:"validation"`
 	ExtensionConditions       *ExtensionConditions `tfsdk:"extension_conditions"`
 	ApprovalSteps             []ApprovalStep       `tfsdk:"approval_steps"`
+	Timeouts                  timeouts.Value       `tfsdk:"timeouts"`
 }
 
 // AccessRuleResource is the data source implementation.
 type AccessWorkflowResource struct {
-	client configv1alpha1connect.AccessWorkflowServiceClient
+	client         configv1alpha1connect.AccessWorkflowServiceClient
+	defaultTimeout time.Duration
+	deployment     providerdata.Deployment
 }
 
 var (
@@ -79,15 +87,18 @@ func (r *AccessWorkflowResource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
-	cfg, ok := req.ProviderData.(*config_client.Context)
+	providerData, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
+	cfg := providerData.Config
+	r.defaultTimeout = providerData.DefaultTimeout
+	r.deployment = providerData.Deployment
 	client := accessworkflow_handler.NewFromConfig(cfg)
 
 	r.client = client
@@ -111,36 +122,49 @@ func (r *AccessWorkflowResource) Schema(ctx context.Context, req resource.Schema
 				MarkdownDescription: "A unique name for the workflow so you know how to identify it.",
 				Optional:            true,
 			},
-			"access_duration_seconds": schema.Int64Attribute{
-				MarkdownDescription: "The maximum allowable duration for the access workflow",
+			"access_duration_seconds": schema.StringAttribute{
+				MarkdownDescription: "The maximum allowable duration for the access workflow, e.g. `8h` or `1h30m`. A number is read as a number of seconds.",
 				Required:            true,
+				CustomType:          duration.Type{},
 			},
-			"try_extend_after_seconds": schema.Int64Attribute{
-				MarkdownDescription: "The amount of time after access is activated that extending access can be attempted. As a starting point we recommend setting this to half of the `access_duration_seconds`.",
+			"try_extend_after_seconds": schema.StringAttribute{
+				MarkdownDescription: "The amount of time after access is activated that extending access can be attempted, e.g. `4h` or `30m`. A number is read as a number of seconds. As a starting point we recommend setting this to half of the `access_duration_seconds`.",
 				Optional:            true,
 				DeprecationMessage:  "This field is no longer supported. Use extension_conditions to configure access workflow extensions",
-				Default:             int64default.StaticInt64(0),
+				Default:             stringdefault.StaticString("0s"),
 				Computed:            true,
+				CustomType:          duration.Type{},
+				// state from before this attribute held a duration string stores the default as "0".
+				PlanModifiers: []planmodifier.String{
+					duration.UseStateForEquivalentDefault(),
+				},
 			},
 			"priority": schema.Int64Attribute{
 				MarkdownDescription: "The priority that governs whether the policy will be used. If a different policy with a higher priority and the same role exists that one will be used over another.",
 				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 			},
-			"activation_expiry": schema.Int64Attribute{
-				MarkdownDescription: "The amount of time after access is approved to be activated before the request will be expired",
+			"activation_expiry": schema.StringAttribute{
+				MarkdownDescription: "The amount of time after access is approved to be activated before the request will be expired, e.g. `8h` or `1h30m`. A number is read as a number of seconds.",
 				Optional:            true,
+				CustomType:          duration.Type{},
 			},
-			"requested_to_approved_expiry": schema.Int64Attribute{
-				MarkdownDescription: "The amount of time after a request is made and approved before the request will be expired",
+			"requested_to_approved_expiry": schema.StringAttribute{
+				MarkdownDescription: "The amount of time after a request is made and approved before the request will be expired, e.g. `8h` or `1h30m`. A number is read as a number of seconds.",
 				Optional:            true,
+				CustomType:          duration.Type{},
 			},
-			"requested_to_activate_expiry": schema.Int64Attribute{
-				MarkdownDescription: "The amount of time after a request is made and activated before the request will be expired",
+			"requested_to_activate_expiry": schema.StringAttribute{
+				MarkdownDescription: "The amount of time after a request is made and activated before the request will be expired, e.g. `8h` or `1h30m`. A number is read as a number of seconds.",
 				Optional:            true,
+				CustomType:          duration.Type{},
 			},
-			"default_duration_seconds": schema.Int64Attribute{
-				MarkdownDescription: "The default duration of the access workflow",
+			"default_duration_seconds": schema.StringAttribute{
+				MarkdownDescription: "The default duration of the access workflow, e.g. `8h` or `1h30m`. A number is read as a number of seconds.",
 				Optional:            true,
+				CustomType:          duration.Type{},
 			},
 			"validation": schema.SingleNestedAttribute{
 				MarkdownDescription: "Validation requirements to be set with this workflow",
@@ -185,16 +209,21 @@ func (r *AccessWorkflowResource) Schema(ctx context.Context, req resource.Schema
 					"maximum_number_of_extensions": schema.Int64Attribute{
 						MarkdownDescription: "The maximum number of allowed extensions (set to 0 to disable extensions). If not set, it defaults to 0.",
 						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
 					},
-					"extension_duration_seconds": schema.Int64Attribute{
-						MarkdownDescription: "Specifies the duration for each extension. Defaults to the value of access_duration_seconds if not provided.",
+					"extension_duration_seconds": schema.StringAttribute{
+						MarkdownDescription: "Specifies the duration for each extension, e.g. `1h`. A number is read as a number of seconds.",
 						Required:            true,
+						CustomType:          duration.Type{},
 					},
 				},
 			},
 			"approval_steps": schema.ListNestedAttribute{
-				MarkdownDescription: "Define the requirements for grant approval, each step must be completed by a distict principal, steps can be completed in any order.",
-				Optional:            true,
+				MarkdownDescription: "Define the requirements for grant approval, each step must be completed by a distict principal, steps can be completed in any order. " +
+					"To require more than one approver from the same group, add a step for each approver with the same `when` expression.",
+				Optional: true,
 
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -205,12 +234,21 @@ func (r *AccessWorkflowResource) Schema(ctx context.Context, req resource.Schema
 						"when": schema.StringAttribute{
 							MarkdownDescription: "The Cedar when expression to evaluate a review for a match.",
 							Required:            true,
+							CustomType:          cedarpolicy.ExpressionType{},
 						},
 					},
 				},
 			},
 		},
 		MarkdownDescription: `Access Workflows are used to describe how long access should be applied. Created Workflows can be referenced in other resources created.`,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -238,32 +276,26 @@ func (r *AccessWorkflowResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	accessDuration := time.Second * time.Duration(data.AccessDuration.ValueInt64())
-	tryExtendAfter := time.Second * time.Duration(data.TryExtendAfter.ValueInt64())
-
-	createReq := &configv1alpha1.CreateAccessWorkflowRequest{
-		Name:           data.Name.ValueString(),
-		AccessDuration: durationpb.New(accessDuration),
-		TryExtendAfter: durationpb.New(tryExtendAfter),
-		Priority:       int32(data.Priority.ValueInt64()),
-	}
-
-	if !data.ActivationExpiry.IsNull() {
-		activationExpiry := time.Second * time.Duration(data.ActivationExpiry.ValueInt64())
-
-		createReq.ActivationExpiry = durationpb.New(activationExpiry)
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.RequestedToActivateExpiry.IsNull() {
-		RequestedToActivateExpiry := time.Second * time.Duration(data.RequestedToActivateExpiry.ValueInt64())
-
-		createReq.RequestToActiveExpiry = durationpb.New(RequestedToActivateExpiry)
-	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
-	if !data.RequestedToApprovedExpiry.IsNull() {
-		RequestedToApprovedExpiry := time.Second * time.Duration(data.RequestedToApprovedExpiry.ValueInt64())
+	accessDuration := data.AccessDuration.Duration()
+	tryExtendAfter := data.TryExtendAfter.Duration()
 
-		createReq.RequestToApproveExpiry = durationpb.New(RequestedToApprovedExpiry)
+	createReq := &configv1alpha1.CreateAccessWorkflowRequest{
+		Name:                   data.Name.ValueString(),
+		AccessDuration:         durationpb.New(accessDuration),
+		TryExtendAfter:         durationpb.New(tryExtendAfter),
+		Priority:               int32(data.Priority.ValueInt64()),
+		ActivationExpiry:       data.ActivationExpiry.ToAPI(),
+		RequestToActiveExpiry:  data.RequestedToActivateExpiry.ToAPI(),
+		RequestToApproveExpiry: data.RequestedToApprovedExpiry.ToAPI(),
 	}
 
 	if data.Validation != nil {
@@ -288,28 +320,24 @@ func (r *AccessWorkflowResource) Create(ctx context.Context, req resource.Create
 
 	// set default duration to access duration by default
 	if !data.DefaultDuration.IsNull() {
-		defaultDuration := time.Second * time.Duration(data.DefaultDuration.ValueInt64())
+		defaultDuration := data.DefaultDuration.Duration()
 		if defaultDuration > accessDuration {
 			resp.Diagnostics.AddError(
 				"Invalid Default Duration",
 				"The default duration must be less than the maximum access duration. "+
 					"Please adjust the Default Duration to be less than Access Duration.\n\n"+
-					"Default Duration: "+defaultDuration.String()+", Access Duration: "+accessDuration.String(),
+					"Default Duration: "+duration.Format(defaultDuration)+", Access Duration: "+duration.Format(accessDuration),
 			)
 			return
 		}
-		createReq.DefaultDuration = durationpb.New(defaultDuration)
+		createReq.DefaultDuration = data.DefaultDuration.ToAPI()
 	}
 
 	if data.ExtensionConditions != nil {
-		cond := accessv1alpha1.ExtensionConditions{}
-		if !data.ExtensionConditions.ExtensionDuration.IsNull() {
-			cond.ExtensionDurationSeconds = durationpb.New(time.Second * time.Duration(data.ExtensionConditions.ExtensionDuration.ValueInt64()))
-		}
-		if !data.ExtensionConditions.MaxExtensions.IsNull() {
-			cond.MaximumNumberOfExtensions = int32(data.ExtensionConditions.MaxExtensions.ValueInt64())
+		createReq.ExtensionConditions = &accessv1alpha1.ExtensionConditions{
+			ExtensionDurationSeconds:  data.ExtensionConditions.ExtensionDuration.ToAPI(),
+			MaximumNumberOfExtensions: int32(data.ExtensionConditions.MaxExtensions.ValueInt64()),
 		}
-		createReq.ExtensionConditions = &cond
 	}
 
 	for _, step := range data.ApprovalSteps {
@@ -332,16 +360,11 @@ func (r *AccessWorkflowResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	// ad, tea := HandleDurationStrings(res.Msg.Workflow.AccessDuration, res.Msg.Workflow.TryExtendAfter)
-
-	// // Convert from the API data model to the Terraform data model
-	// // and set any unknown attribute values.
-	data.ID = types.StringValue(res.Msg.Workflow.Id)
-	// data.AccessDuration = data.TryExtendAfter
-	// data.TryExtendAfter = data.TryExtendAfter
+	state := accessWorkflowModelFromAPI(res.Msg.Workflow, *data)
 
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -358,6 +381,23 @@ func (r *AccessWorkflowResource) Read(ctx context.Context, req resource.ReadRequ
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// read the state from the client
 	res, err := r.client.GetAccessWorkflow(ctx, connect.NewRequest(&configv1alpha1.GetAccessWorkflowRequest{
@@ -374,65 +414,10 @@ func (r *AccessWorkflowResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	// refresh state
-
-	state.ID = types.StringValue(res.Msg.Workflow.Id)
-	state.Name = types.StringValue(res.Msg.Workflow.Name)
-	state.AccessDuration = types.Int64Value(res.Msg.Workflow.AccessDuration.Seconds)
-	state.Priority = types.Int64Value(int64(res.Msg.Workflow.Priority))
-	state.TryExtendAfter = types.Int64Value(res.Msg.Workflow.TryExtendAfter.Seconds)
-
-	if res.Msg.Workflow.DefaultDuration != nil {
-		state.DefaultDuration = types.Int64Value(res.Msg.Workflow.DefaultDuration.Seconds)
-	}
-
-	if res.Msg.Workflow.ActivationExpiry != nil {
-		state.ActivationExpiry = types.Int64Value(res.Msg.Workflow.ActivationExpiry.Seconds)
-
-	}
-
-	if res.Msg.Workflow.RequestToActiveExpiry != nil {
-		state.RequestedToActivateExpiry = types.Int64Value(res.Msg.Workflow.RequestToActiveExpiry.Seconds)
-
-	}
-
-	if res.Msg.Workflow.RequestToApproveExpiry != nil {
-		state.RequestedToApprovedExpiry = types.Int64Value(res.Msg.Workflow.RequestToApproveExpiry.Seconds)
-
-	}
-	if res.Msg.Workflow.Validation != nil {
-		var regexValidations []RegexValidation
-
-		for _, r := range res.Msg.Workflow.Validation.ReasonRegex {
-			regexValidations = append(regexValidations, RegexValidation{
-				RegexPattern: types.StringValue(r.RegexPattern),
-				ErrorMessage: types.StringValue(r.ErrorMessage),
-			})
-		}
-
-		state.Validation = &Validations{
-			HasReason:     types.BoolValue(res.Msg.Workflow.Validation.HasReason),
-			ReasonRegex:   regexValidations,
-			HasJiraTicket: types.BoolValue(res.Msg.Workflow.Validation.HasJiraTicket),
-		}
-	}
-
-	if res.Msg.Workflow.ExtensionConditions != nil {
-		state.ExtensionConditions = &ExtensionConditions{
-			ExtensionDuration: types.Int64Value(res.Msg.Workflow.ExtensionConditions.ExtensionDurationSeconds.Seconds),
-			MaxExtensions:     types.Int64Value(int64(res.Msg.Workflow.ExtensionConditions.MaximumNumberOfExtensions)),
-		}
-	}
-
-	state.ApprovalSteps = nil
-	for _, step := range res.Msg.Workflow.ApprovalSteps {
-		state.ApprovalSteps = append(state.ApprovalSteps, ApprovalStep{
-			Name: types.StringValue(step.Name),
-			When: types.StringValue(step.When),
-		})
-	}
+	state = accessWorkflowModelFromAPI(res.Msg.Workflow, state)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *AccessWorkflowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -455,35 +440,34 @@ func (r *AccessWorkflowResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	accessDuration := time.Second * time.Duration(data.AccessDuration.ValueInt64())
-	tryExtendAfter := time.Second * time.Duration(data.TryExtendAfter.ValueInt64())
-
-	updateReq := &configv1alpha1.UpdateAccessWorkflowRequest{
-		Workflow: &configv1alpha1.AccessWorkflow{
-			Id:             data.ID.ValueString(),
-			Name:           data.Name.ValueString(),
-			AccessDuration: durationpb.New(accessDuration),
-			TryExtendAfter: durationpb.New(tryExtendAfter),
-			Priority:       int32(data.Priority.ValueInt64()),
-		},
-	}
-
-	if !data.ActivationExpiry.IsNull() {
-		activationExpiry := time.Second * time.Duration(data.ActivationExpiry.ValueInt64())
-
-		updateReq.Workflow.ActivationExpiry = durationpb.New(activationExpiry)
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.RequestedToActivateExpiry.IsNull() {
-		RequestedToActivateExpiry := time.Second * time.Duration(data.RequestedToActivateExpiry.ValueInt64())
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
-		updateReq.Workflow.RequestToActiveExpiry = durationpb.New(RequestedToActivateExpiry)
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.RequestedToApprovedExpiry.IsNull() {
-		RequestedToApprovedExpiry := time.Second * time.Duration(data.RequestedToApprovedExpiry.ValueInt64())
+	accessDuration := data.AccessDuration.Duration()
+	tryExtendAfter := data.TryExtendAfter.Duration()
 
-		updateReq.Workflow.RequestToApproveExpiry = durationpb.New(RequestedToApprovedExpiry)
+	updateReq := &configv1alpha1.UpdateAccessWorkflowRequest{
+		Workflow: &configv1alpha1.AccessWorkflow{
+			Id:                     data.ID.ValueString(),
+			Name:                   data.Name.ValueString(),
+			AccessDuration:         durationpb.New(accessDuration),
+			TryExtendAfter:         durationpb.New(tryExtendAfter),
+			Priority:               int32(data.Priority.ValueInt64()),
+			ActivationExpiry:       data.ActivationExpiry.ToAPI(),
+			RequestToActiveExpiry:  data.RequestedToActivateExpiry.ToAPI(),
+			RequestToApproveExpiry: data.RequestedToApprovedExpiry.ToAPI(),
+		},
 	}
 
 	if data.Validation != nil {
@@ -506,28 +490,24 @@ func (r *AccessWorkflowResource) Update(ctx context.Context, req resource.Update
 	// set default duration to access duration by default
 	if !data.DefaultDuration.IsNull() {
 
-		defaultDuration := time.Second * time.Duration(data.DefaultDuration.ValueInt64())
+		defaultDuration := data.DefaultDuration.Duration()
 		if defaultDuration > accessDuration {
 			resp.Diagnostics.AddError(
 				"Invalid Default Duration",
 				"The default duration must be less than the maximum access duration. "+
 					"Please adjust the Default Duration to be less than Access Duration.\n\n"+
-					"Default Duration: "+defaultDuration.String()+", Access Duration: "+accessDuration.String(),
+					"Default Duration: "+duration.Format(defaultDuration)+", Access Duration: "+duration.Format(accessDuration),
 			)
 			return
 		}
-		updateReq.Workflow.DefaultDuration = durationpb.New(defaultDuration)
+		updateReq.Workflow.DefaultDuration = data.DefaultDuration.ToAPI()
 	}
 
 	if data.ExtensionConditions != nil {
-		cond := accessv1alpha1.ExtensionConditions{}
-		if !data.ExtensionConditions.ExtensionDuration.IsNull() {
-			cond.ExtensionDurationSeconds = durationpb.New(time.Second * time.Duration(data.ExtensionConditions.ExtensionDuration.ValueInt64()))
-		}
-		if !data.ExtensionConditions.MaxExtensions.IsNull() {
-			cond.MaximumNumberOfExtensions = int32(data.ExtensionConditions.MaxExtensions.ValueInt64())
+		updateReq.Workflow.ExtensionConditions = &accessv1alpha1.ExtensionConditions{
+			ExtensionDurationSeconds:  data.ExtensionConditions.ExtensionDuration.ToAPI(),
+			MaximumNumberOfExtensions: int32(data.ExtensionConditions.MaxExtensions.ValueInt64()),
 		}
-		updateReq.Workflow.ExtensionConditions = &cond
 	}
 
 	for _, step := range data.ApprovalSteps {
@@ -551,26 +531,10 @@ func (r *AccessWorkflowResource) Update(ctx context.Context, req resource.Update
 
 	}
 
-	// Convert from the API data model to the Terraform data model
-	// and set any unknown attribute values.
-	data.ID = types.StringValue(res.Msg.Workflow.Id)
-	data.AccessDuration = types.Int64Value(res.Msg.Workflow.AccessDuration.Seconds)
-	data.TryExtendAfter = types.Int64Value(res.Msg.Workflow.TryExtendAfter.Seconds)
-
-	if res.Msg.Workflow.ActivationExpiry == nil {
-		data.ActivationExpiry = types.Int64Null()
-	} else {
-		data.ActivationExpiry = types.Int64Value(res.Msg.Workflow.ActivationExpiry.Seconds)
-
-	}
-
-	if res.Msg.Workflow.DefaultDuration == nil {
-		data.DefaultDuration = types.Int64Null()
-	} else {
-		data.DefaultDuration = types.Int64Value(res.Msg.Workflow.DefaultDuration.Seconds)
-	}
+	data = accessWorkflowModelFromAPI(res.Msg.Workflow, data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *AccessWorkflowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -593,6 +557,20 @@ func (r *AccessWorkflowResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.DeleteAccessWorkflow(ctx, connect.NewRequest(&configv1alpha1.DeleteAccessWorkflowRequest{
 		Id: data.ID.ValueString(),
 	}))
@@ -613,3 +591,46 @@ func (r *AccessWorkflowResource) ImportState(ctx context.Context, req resource.I
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// accessWorkflowModelFromAPI converts an access workflow returned by the API into the resource model.
+// Every attribute is set from the API, so that changes made outside of Terraform show up as drift.
+//
+// prior is the plan or state the workflow was fetched for. The API returns unset names and priorities
+// as their zero value, so they stay null if they were null in prior to avoid a perpetual diff.
+func accessWorkflowModelFromAPI(w *configv1alpha1.AccessWorkflow, prior AccessWorkflowModel) AccessWorkflowModel {
+	model := AccessWorkflowModel{
+		ID:                        types.StringValue(w.Id),
+		Name:                      types.StringValue(w.Name),
+		AccessDuration:            duration.FromAPI(w.AccessDuration),
+		TryExtendAfter:            duration.NewValue(w.TryExtendAfter.AsDuration()),
+		Priority:                  types.Int64Value(int64(w.Priority)),
+		ActivationExpiry:          duration.FromAPI(w.ActivationExpiry),
+		RequestedToApprovedExpiry: duration.FromAPI(w.RequestToApproveExpiry),
+		RequestedToActivateExpiry: duration.FromAPI(w.RequestToActiveExpiry),
+		DefaultDuration:           duration.FromAPI(w.DefaultDuration),
+		Validation:                validationsFromAPI(w.Validation),
+		ExtensionConditions:       extensionConditionsFromAPI(w.ExtensionConditions),
+		ApprovalSteps:             approvalStepsFromAPI(w.ApprovalSteps),
+		Timeouts:                  prior.Timeouts,
+	}
+
+	if w.Name == "" && prior.Name.IsNull() {
+		model.Name = types.StringNull()
+	}
+	if w.Priority == 0 && prior.Priority.IsNull() {
+		model.Priority = types.Int64Null()
+	}
+
+	return model
+}
+
+func extensionConditionsFromAPI(c *accessv1alpha1.ExtensionConditions) *ExtensionConditions {
+	if c == nil {
+		return nil
+	}
+
+	return &ExtensionConditions{
+		ExtensionDuration: duration.FromAPI(c.ExtensionDurationSeconds),
+		MaxExtensions:     types.Int64Value(int64(c.MaximumNumberOfExtensions)),
+	}
+}
@@ -1,63 +1,428 @@
-package access
-
-// func TestAccAccessWorkflow(t *testing.T) {
-// 	resource.Test(t, resource.TestCase{
-// 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-// 		Steps: []resource.TestStep{
-// 			// Create and Read testing
-// 			{
-
-// 				Config: providerConfig + `
-
-// 				  resource "commonfate_access_workflow" "test" {
-// 					name     = "test"
-// 					access_duration_seconds = 2000
-// 					priority = 1
-// 					try_extend_after_seconds = 1000
-// 				  }
-
-// `,
-// 				Check: resource.ComposeAggregateTestCheckFunc(
-
-// 					resource.TestCheckResourceAttr("commonfate_access_workflow.test", "name", "test"),
-// 					resource.TestCheckResourceAttr("commonfate_access_workflow.test", "access_duration_seconds", "2000"),
-// 					resource.TestCheckResourceAttr("commonfate_access_workflow.test", "priority", "1"),
-// 					resource.TestCheckResourceAttr("commonfate_access_workflow.test", "try_extend_after_seconds", "1000"),
-
-// 					// Verify dynamic values have any value set in the state.
-// 					resource.TestCheckResourceAttrSet("commonfate_access_workflow.test", "id"),
-// 				),
-// 			},
-// 			// ImportState testing
-// 			// {
-// 			// 	ResourceName:      "commonfate_access_workflow.test",
-// 			// 	ImportState:       true,
-// 			// 	ImportStateVerify: true,
-// 			// },
-// 			// // Update and Read testing
-// 			// {
-// 			// 	Config: providerConfig + `
-
-// 			// 	resource "commonfate_access_workflow" "test" {
-// 			// 		name     = "test-updated"
-// 			// 		access_duration = "1h"
-// 			// 		priority = 2
-// 			// 		try_extend_after="10m"
-// 			// 	  }
-
-// 			// 			`,
-// 			// 	Check: resource.ComposeAggregateTestCheckFunc(
-// 			// 		// Verify first order item updated
-// 			// 		resource.TestCheckResourceAttr("commonfate_access_workflow.test", "name", "test-updated"),
-// 			// 		resource.TestCheckResourceAttr("commonfate_access_workflow.test", "access_duration", "1h"),
-// 			// 		resource.TestCheckResourceAttr("commonfate_access_workflow.test", "priority", "2"),
-// 			// 		resource.TestCheckResourceAttr("commonfate_access_workflow.test", "try_extend_after", "10m"),
-
-// 			// 		// Verify dynamic values have any value set in the state.
-// 			// 		// resource.TestCheckResourceAttrSet("commonfate_access_workflow.test", "id"),
-// 			// 	),
-// 			// },
-// 			// Delete testing automatically occurs in TestCase
-// 		},
-// 	})
-// }
+package access_test
+
+import (
+	"regexp"
+	"testing"
+
+	configv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1"
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAccessWorkflow(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_access_workflow.test",
+			Config: `
+resource "commonfate_access_workflow" "test" {
+  name                    = "test"
+  access_duration_seconds = "33m20s"
+  priority                = 1
+}
+`,
+			Check: map[string]string{
+				"name":                     "test",
+				"access_duration_seconds":  "33m20s",
+				"priority":                 "1",
+				"try_extend_after_seconds": "0s",
+			},
+			UpdatedConfig: `
+resource "commonfate_access_workflow" "test" {
+  name                     = "test-updated"
+  access_duration_seconds  = "1h"
+  default_duration_seconds = "30m"
+  priority                 = 2
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name":                     "test-updated",
+				"access_duration_seconds":  "1h",
+				"default_duration_seconds": "30m",
+				"priority":                 "2",
+			},
+		},
+		{
+			Name:     "all_fields",
+			Resource: "commonfate_access_workflow.test",
+			Config: `
+resource "commonfate_access_workflow" "test" {
+  name                         = "test"
+  access_duration_seconds      = "2h"
+  default_duration_seconds     = "1h"
+  priority                     = 10
+  try_extend_after_seconds     = "30m"
+  activation_expiry            = "10m"
+  requested_to_approved_expiry = "20m"
+  requested_to_activate_expiry = "30m"
+
+  validation = {
+    has_reason = true
+    reason_regex = [
+      {
+        regex_pattern = "^[A-Z]+-[0-9]+$"
+        error_message = "must be a ticket number"
+      },
+    ]
+  }
+
+  extension_conditions = {
+    maximum_number_of_extensions = 2
+    extension_duration_seconds   = "1h"
+  }
+
+  approval_steps = [
+    {
+      name = "manager"
+      when = "principal in Group::\"managers\""
+    },
+  ]
+}
+`,
+			Check: map[string]string{
+				"try_extend_after_seconds":                          "30m",
+				"activation_expiry":                                 "10m",
+				"requested_to_approved_expiry":                      "20m",
+				"requested_to_activate_expiry":                      "30m",
+				"validation.has_reason":                             "true",
+				"validation.has_jira_ticket":                        "false",
+				"validation.reason_regex.#":                         "1",
+				"validation.reason_regex.0.regex_pattern":           "^[A-Z]+-[0-9]+$",
+				"extension_conditions.maximum_number_of_extensions": "2",
+				"extension_conditions.extension_duration_seconds":   "1h",
+				"approval_steps.#":                                  "1",
+				"approval_steps.0.name":                             "manager",
+				"approval_steps.0.when":                             "principal in Group::\"managers\"",
+			},
+			UpdatedConfig: `
+resource "commonfate_access_workflow" "test" {
+  name                         = "test"
+  access_duration_seconds      = "2h"
+  default_duration_seconds     = "1h"
+  priority                     = 10
+  activation_expiry            = "15m"
+  requested_to_approved_expiry = "20m"
+  requested_to_activate_expiry = "30m"
+
+  validation = {
+    has_reason      = true
+    has_jira_ticket = true
+  }
+
+  approval_steps = [
+    {
+      name = "manager"
+      when = "principal in Group::\"managers\""
+    },
+    {
+      name = "security"
+      when = "principal in Group::\"security\""
+    },
+  ]
+}
+`,
+			UpdatedCheck: map[string]string{
+				"activation_expiry":          "15m",
+				"validation.has_jira_ticket": "true",
+				"validation.reason_regex.#":  "0",
+				"approval_steps.#":           "2",
+				"approval_steps.1.name":      "security",
+			},
+		},
+	})
+}
+
+func TestAccAccessWorkflow_EquivalentWhen(t *testing.T) {
+	s := acctest.NewServer(t)
+
+	config := acctest.ProviderConfig(s) + `
+resource "commonfate_access_workflow" "test" {
+  name                     = "test"
+  access_duration_seconds  = 3600
+  default_duration_seconds = 3600
+  priority                 = 1
+
+  approval_steps = [
+    {
+      name = "manager"
+      when = "principal in Group::\"managers\" && resource.tags.contains(\"prod\")"
+    },
+  ]
+}
+`
+
+	var id string
+	// editWhen changes the approval step's when expression in the API.
+	editWhen := func(when string) {
+		if !s.EditAccessWorkflow(id, func(w *configv1alpha1.AccessWorkflow) { w.ApprovalSteps[0].When = when }) {
+			t.Fatalf("access workflow %q not found", id)
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: func(state *terraform.State) error {
+					id = state.RootModule().Resources["commonfate_access_workflow.test"].Primary.ID
+					return nil
+				},
+			},
+			{
+				// the reformatted expression is equivalent, so there's nothing to change.
+				PreConfig: func() { editWhen("(principal in Group::\"managers\")\n  && resource.tags.contains(\"prod\")") },
+				Config:    config,
+				PlanOnly:  true,
+			},
+			{
+				PreConfig:          func() { editWhen("principal in Group::\"admins\"") },
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAccessWorkflow_Drift(t *testing.T) {
+	s := acctest.NewServer(t)
+
+	config := acctest.ProviderConfig(s) + `
+resource "commonfate_access_workflow" "test" {
+  name                    = "test"
+  access_duration_seconds = 3600
+  priority                = 1
+  activation_expiry       = 600
+
+  validation = {
+    has_reason = true
+  }
+
+  extension_conditions = {
+    maximum_number_of_extensions = 2
+    extension_duration_seconds   = 1800
+  }
+}
+`
+
+	var id string
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: func(state *terraform.State) error {
+					id = state.RootModule().Resources["commonfate_access_workflow.test"].Primary.ID
+					return nil
+				},
+			},
+			{
+				// fields cleared in the console must show up in the plan rather than being kept from the state.
+				PreConfig: func() {
+					if !s.EditAccessWorkflow(id, func(w *configv1alpha1.AccessWorkflow) {
+						w.ActivationExpiry = nil
+						w.Validation = nil
+						w.ExtensionConditions = nil
+					}) {
+						t.Fatalf("access workflow %q not found", id)
+					}
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("commonfate_access_workflow.test", "activation_expiry", "600"),
+					resource.TestCheckResourceAttr("commonfate_access_workflow.test", "validation.has_reason", "true"),
+					resource.TestCheckResourceAttr("commonfate_access_workflow.test", "extension_conditions.maximum_number_of_extensions", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessWorkflow_Durations(t *testing.T) {
+	s := acctest.NewServer(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(s) + `
+resource "commonfate_access_workflow" "test" {
+  name                    = "test"
+  access_duration_seconds = "8 hours"
+  priority                = 1
+}
+`,
+				ExpectError: regexp.MustCompile(`"8 hours" is not a valid duration`),
+			},
+			{
+				// durations written as a number of seconds keep working, and durations which the API
+				// returns in another form, such as 90m as 1h30m, don't show up as a diff after the apply.
+				Config: acctest.ProviderConfig(s) + `
+resource "commonfate_access_workflow" "test" {
+  name                     = "test"
+  access_duration_seconds  = 7200
+  default_duration_seconds = "90m"
+  try_extend_after_seconds = 1800
+  priority                 = 1
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("commonfate_access_workflow.test", "access_duration_seconds", "7200"),
+					resource.TestCheckResourceAttr("commonfate_access_workflow.test", "default_duration_seconds", "90m"),
+					resource.TestCheckResourceAttr("commonfate_access_workflow.test", "try_extend_after_seconds", "1800"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessWorkflow_TryExtendAfterUpgrade(t *testing.T) {
+	s := acctest.NewServer(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// try_extend_after_seconds used to be a number defaulting to 0, which is stored in state as "0".
+				Config: acctest.ProviderConfig(s) + `
+resource "commonfate_access_workflow" "test" {
+  name                     = "test"
+  access_duration_seconds  = "1h"
+  try_extend_after_seconds = 0
+  priority                 = 1
+}
+`,
+				Check: resource.TestCheckResourceAttr("commonfate_access_workflow.test", "try_extend_after_seconds", "0"),
+			},
+			{
+				// leaving it unset defaults it to "0s", which must not show up as a change.
+				Config: acctest.ProviderConfig(s) + `
+resource "commonfate_access_workflow" "test" {
+  name                    = "test"
+  access_duration_seconds = "1h"
+  priority                = 1
+}
+`,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAccessWorkflow_Validation(t *testing.T) {
+	s := acctest.NewServer(t)
+
+	tests := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name: "default duration longer than access duration",
+			config: `
+  access_duration_seconds  = "1h"
+  default_duration_seconds = "90m"
+`,
+			wantErr: `Invalid Default Duration`,
+		},
+		{
+			name: "extension duration longer than access duration",
+			config: `
+  access_duration_seconds = "1h"
+  extension_conditions = {
+    maximum_number_of_extensions = 1
+    extension_duration_seconds   = "2h"
+  }
+`,
+			wantErr: `Invalid Extension Duration`,
+		},
+		{
+			name: "negative priority",
+			config: `
+  access_duration_seconds = "1h"
+  priority                = -1
+`,
+			wantErr: `priority value must be at\s+least 0`,
+		},
+		{
+			name: "negative maximum number of extensions",
+			config: `
+  access_duration_seconds = "1h"
+  extension_conditions = {
+    maximum_number_of_extensions = -1
+    extension_duration_seconds   = "30m"
+  }
+`,
+			wantErr: `value must be at\s+least 0`,
+		},
+		{
+			name: "reason regex without a reason",
+			config: `
+  access_duration_seconds = "1h"
+  validation = {
+    has_reason = false
+    reason_regex = [
+      {
+        regex_pattern = "^[A-Z]+-[0-9]+$"
+        error_message = "must be a ticket number"
+      },
+    ]
+  }
+`,
+			wantErr: `Invalid Reason Validation`,
+		},
+		{
+			name: "reason regex with has_reason unset",
+			config: `
+  access_duration_seconds = "1h"
+  validation = {
+    reason_regex = [
+      {
+        regex_pattern = "^[A-Z]+-[0-9]+$"
+        error_message = "must be a ticket number"
+      },
+    ]
+  }
+`,
+			wantErr: `Invalid Reason Validation`,
+		},
+		{
+			name: "invalid reason regex",
+			config: `
+  access_duration_seconds = "1h"
+  validation = {
+    has_reason = true
+    reason_regex = [
+      {
+        regex_pattern = "^([A-Z]+-[0-9]+$"
+        error_message = "must be a ticket number"
+      },
+    ]
+  }
+`,
+			wantErr: `Invalid Reason Regex`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource.ParallelTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config:      acctest.ProviderConfig(s) + "resource \"commonfate_access_workflow\" \"test\" {\n  name = \"test\"\n" + tt.config + "}\n",
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tt.wantErr),
+					},
+				},
+			})
+		})
+	}
+}
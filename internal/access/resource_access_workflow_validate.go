@@ -0,0 +1,115 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/duration"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithConfigValidators = &AccessWorkflowResource{}
+
+// ConfigValidators checks the attributes of an access workflow against each other,
+// so that inconsistent workflows are reported by 'terraform validate' rather than the API.
+func (r *AccessWorkflowResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		withinAccessDuration{
+			attr: path.Root("default_duration_seconds"),
+			name: "Default Duration",
+		},
+		withinAccessDuration{
+			attr: path.Root("extension_conditions").AtName("extension_duration_seconds"),
+			name: "Extension Duration",
+		},
+		reasonRegexValidator{},
+	}
+}
+
+// withinAccessDuration checks that a duration isn't longer than access_duration_seconds.
+type withinAccessDuration struct {
+	attr path.Path
+	name string
+}
+
+func (v withinAccessDuration) Description(_ context.Context) string {
+	return fmt.Sprintf("%s must not be longer than access_duration_seconds", v.attr)
+}
+
+func (v withinAccessDuration) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v withinAccessDuration) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var d, accessDuration duration.Value
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, v.attr, &d)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("access_duration_seconds"), &accessDuration)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if d.IsNull() || d.IsUnknown() || accessDuration.IsNull() || accessDuration.IsUnknown() {
+		return
+	}
+
+	if d.Duration() > accessDuration.Duration() {
+		resp.Diagnostics.AddAttributeError(
+			v.attr,
+			"Invalid "+v.name,
+			fmt.Sprintf("The %s must not be longer than the maximum access duration. "+
+				"Please adjust %s to be at most access_duration_seconds.\n\n"+
+				"%s: %s, Access Duration: %s", v.name, v.attr, v.name, duration.Format(d.Duration()), duration.Format(accessDuration.Duration())),
+		)
+	}
+}
+
+// reasonRegexValidator checks that reason regexes compile, and that they aren't
+// configured on a workflow which doesn't ask for a reason. has_reason defaults to
+// false, so leaving it unset doesn't ask for a reason either.
+type reasonRegexValidator struct{}
+
+func (v reasonRegexValidator) Description(_ context.Context) string {
+	return "validation.reason_regex patterns must be valid regular expressions, and can only be set when validation.has_reason is true"
+}
+
+func (v reasonRegexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v reasonRegexValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var hasReason types.Bool
+	var reasonRegex types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("validation").AtName("has_reason"), &hasReason)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("validation").AtName("reason_regex"), &reasonRegex)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if reasonRegex.IsNull() || reasonRegex.IsUnknown() {
+		return
+	}
+
+	regexPath := path.Root("validation").AtName("reason_regex")
+	// a null has_reason is false, which is its default.
+	if !hasReason.IsUnknown() && !hasReason.ValueBool() && len(reasonRegex.Elements()) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			regexPath,
+			"Invalid Reason Validation",
+			"reason_regex checks the reason given for a request, but has_reason isn't true so a reason isn't asked for. "+
+				"Set has_reason to true, or remove reason_regex.",
+		)
+	}
+
+	for i := range reasonRegex.Elements() {
+		var pattern types.String
+		p := regexPath.AtListIndex(i).AtName("regex_pattern")
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, p, &pattern)...)
+		if pattern.IsNull() || pattern.IsUnknown() {
+			continue
+		}
+		if _, err := regexp.Compile(pattern.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(p, "Invalid Reason Regex", fmt.Sprintf("%q is not a valid regular expression: %s", pattern.ValueString(), err))
+		}
+	}
+}
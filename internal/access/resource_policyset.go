@@ -3,11 +3,16 @@ package access
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
-	config_client "github.com/common-fate/sdk/config"
 	authzv1alpha1 "github.com/common-fate/sdk/gen/commonfate/authz/v1alpha1"
 	"github.com/common-fate/sdk/service/authz/policyset"
+	"github.com/common-fate/terraform-provider-commonfate/internal/cedarpolicy"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,18 +20,25 @@ import (
 )
 
 type PolicyModel struct {
-	ID   types.String `tfsdk:"id"`
-	Text types.String `tfsdk:"text"`
+	ID       types.String      `tfsdk:"id"`
+	Text     cedarpolicy.Text  `tfsdk:"text"`
+	Policies []PolicyStatement `tfsdk:"policies"`
+	Timeouts timeouts.Value    `tfsdk:"timeouts"`
 }
 
 type PolicySetResource struct {
-	client *policyset.Client
+	client         *policyset.Client
+	defaultTimeout time.Duration
+	deployment     providerdata.Deployment
 }
 
 var (
-	_ resource.Resource                = &PolicySetResource{}
-	_ resource.ResourceWithConfigure   = &PolicySetResource{}
-	_ resource.ResourceWithImportState = &PolicySetResource{}
+	_ resource.Resource                     = &PolicySetResource{}
+	_ resource.ResourceWithConfigure        = &PolicySetResource{}
+	_ resource.ResourceWithImportState      = &PolicySetResource{}
+	_ resource.ResourceWithValidateConfig   = &PolicySetResource{}
+	_ resource.ResourceWithConfigValidators = &PolicySetResource{}
+	_ resource.ResourceWithModifyPlan       = &PolicySetResource{}
 )
 
 // Metadata returns the data source type name.
@@ -40,15 +52,18 @@ func (r *PolicySetResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	cfg, ok := req.ProviderData.(*config_client.Context)
+	providerData, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
+	cfg := providerData.Config
+	r.defaultTimeout = providerData.DefaultTimeout
+	r.deployment = providerData.Deployment
 	client := policyset.NewFromConfig(cfg)
 
 	r.client = &client
@@ -68,12 +83,126 @@ func (r *PolicySetResource) Schema(ctx context.Context, req resource.SchemaReque
 			},
 
 			"text": schema.StringAttribute{
-				MarkdownDescription: "The Cedar policy to define permissions as policies in your Common Fate instance.",
-				Required:            true,
+				MarkdownDescription: "The Cedar policy to define permissions as policies in your Common Fate instance. The policy is parsed and checked against the Common Fate schema when the configuration is validated. " +
+					"If `policies` is set, this is the Cedar text they render to. Exactly one of `text` or `policies` must be set.",
+				Optional:   true,
+				Computed:   true,
+				CustomType: cedarpolicy.TextType{},
 			},
+
+			"policies": policyStatementsAttribute(),
 		},
 		MarkdownDescription: `Creates a Cedar PolicySet used to authorize access decisions in Common Fate.`,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *PolicySetResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("text"),
+			path.MatchRoot("policies"),
+		),
+	}
+}
+
+// ValidateConfig parses the policy text, so that syntax errors and references to entity types
+// and actions which aren't in the Common Fate schema are reported without calling the API.
+func (r *PolicySetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var text cedarpolicy.Text
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("text"), &text)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !text.IsNull() && !text.IsUnknown() {
+		resp.Diagnostics.Append(cedarpolicy.Validate(path.Root("text"), text.ValueString())...)
+	}
+
+	policies, ok := configuredPolicies(ctx, req.Config)
+	if !ok {
+		return
+	}
+	for i, p := range policies {
+		policy, known := p.toCedar()
+		if known {
+			resp.Diagnostics.Append(cedarpolicy.ValidatePolicy(path.Root("policies").AtListIndex(i), policy)...)
+		}
+	}
+}
+
+// ModifyPlan renders the policies to Cedar, so that the text they produce is shown in the plan.
+func (r *PolicySetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	policies, ok := configuredPolicies(ctx, req.Plan)
+	if !ok {
+		return
+	}
+
+	text, known, diags := renderPolicies(policies)
+	resp.Diagnostics.Append(diags...)
+	if !known || resp.Diagnostics.HasError() {
+		return
+	}
+
+	// keep the text which is already in state if it's equivalent, so that the plan is empty.
+	var prior cedarpolicy.Text
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("text"), &prior)...)
+	}
+	if !prior.IsNull() && cedarpolicy.Equal(prior.ValueString(), text) {
+		text = prior.ValueString()
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("text"), cedarpolicy.NewTextValue(text))...)
+}
+
+// configuredPolicies returns the policies which are set in the config or plan. It returns false
+// if policies aren't set, or if they can't be read yet because parts of them are unknown.
+func configuredPolicies(ctx context.Context, data interface {
+	GetAttribute(context.Context, path.Path, interface{}) diag.Diagnostics
+}) ([]PolicyStatement, bool) {
+	var list types.List
+	if diags := data.GetAttribute(ctx, path.Root("policies"), &list); diags.HasError() || list.IsNull() || list.IsUnknown() {
+		return nil, false
+	}
+
+	var policies []PolicyStatement
+	if diags := list.ElementsAs(ctx, &policies, false); diags.HasError() {
+		return nil, false
+	}
+	return policies, true
+}
+
+// renderPolicies renders the policies to Cedar text. It returns false if any of the policies are unknown.
+func renderPolicies(policies []PolicyStatement) (string, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	converted := make([]cedarpolicy.Policy, len(policies))
+	for i, p := range policies {
+		policy, known := p.toCedar()
+		if !known {
+			return "", false, diags
+		}
+		converted[i] = policy
 	}
+
+	text, err := cedarpolicy.Render(converted)
+	if err != nil {
+		diags.AddAttributeError(path.Root("policies"), "Invalid Policy", err.Error())
+		return "", false, diags
+	}
+	return text, true, diags
 }
 
 func (r *PolicySetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -100,6 +229,15 @@ func (r *PolicySetResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	_, err := r.client.Create(ctx, policyset.CreateInput{
 		PolicySet: policyset.Input{
 			ID:   data.ID.ValueString(),
@@ -120,6 +258,7 @@ func (r *PolicySetResource) Create(ctx context.Context, req resource.CreateReque
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -136,6 +275,23 @@ func (r *PolicySetResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	//read the state from the client
 	got, err := r.client.Get(ctx, policyset.GetInput{
@@ -153,9 +309,24 @@ func (r *PolicySetResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	state.ID = types.StringValue(got.PolicySet.ID)
-	state.Text = types.StringValue(got.PolicySet.Text)
+
+	// the policies are only read back from the text when it has changed outside of Terraform,
+	// as reformatting alone is ignored by the text's semantic equality.
+	if state.Policies != nil && !cedarpolicy.Equal(state.Text.ValueString(), got.PolicySet.Text) {
+		policies, err := cedarpolicy.FromText(got.PolicySet.Text)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Failed to parse PolicySet",
+				fmt.Sprintf("The policyset %q was changed outside of Terraform and its text could not be parsed into policies: %s.", state.ID.ValueString(), err),
+			)
+		} else {
+			state.Policies = policyStatementsFromCedar(policies)
+		}
+	}
+	state.Text = cedarpolicy.NewTextValue(got.PolicySet.Text)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *PolicySetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -178,6 +349,20 @@ func (r *PolicySetResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var original PolicyModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &original)...)
 	if resp.Diagnostics.HasError() {
@@ -237,9 +422,10 @@ func (r *PolicySetResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	data.ID = types.StringValue(out.Id)
-	data.Text = types.StringValue(out.Text)
+	data.Text = cedarpolicy.NewTextValue(out.Text)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *PolicySetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -262,6 +448,20 @@ func (r *PolicySetResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.Delete(ctx, policyset.DeleteInput{
 		ID: data.ID.ValueString(),
 	})
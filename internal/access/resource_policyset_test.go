@@ -1,53 +1,303 @@
-package access
-
-// func TestAccPolicySet(t *testing.T) {
-// 	resource.Test(t, resource.TestCase{
-// 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-// 		Steps: []resource.TestStep{
-// 			// Create and Read testing
-// 			{
-
-// 				Config: providerConfig + `
-
-// 				  resource "commonfate_policyset" "test" {
-// 					id     = "test"
-// 					text = "test"
-
-// 				  }
-
-// `,
-// 				Check: resource.ComposeAggregateTestCheckFunc(
-
-// 					resource.TestCheckResourceAttr("commonfate_access_workflow.test", "id", "test"),
-// 					resource.TestCheckResourceAttr("commonfate_access_workflow.test", "text", "test"),
-// 				),
-// 			},
-// 			// ImportState testing
-// 			{
-// 				ResourceName:      "commonfate_policyset.test",
-// 				ImportState:       true,
-// 				ImportStateVerify: true,
-// 			},
-// 			// Update and Read testing
-// 			{
-// 				Config: providerConfig + `
-
-// 				resource "commonfate_policyset" "test" {
-// 					id     = "test-updated"
-// 					text = "test-updated"
-// 				  }
-
-// 						`,
-// 				Check: resource.ComposeAggregateTestCheckFunc(
-// 					// Verify first order item updated
-// 					resource.TestCheckResourceAttr("commonfate_access_workflow.test", "id", "test-updated"),
-// 					resource.TestCheckResourceAttr("commonfate_access_workflow.test", "text", "test-updated"),
-
-// 					// Verify dynamic values have any value set in the state.
-// 					// resource.TestCheckResourceAttrSet("commonfate_access_workflow.test", "id"),
-// 				),
-// 			},
-// 			// Delete testing automatically occurs in TestCase
-// 		},
-// 	})
-// }
+package access_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPolicySet(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_policyset.test",
+			Config: `
+resource "commonfate_policyset" "test" {
+  id   = "test"
+  text = "permit(principal, action, resource);"
+}
+`,
+			Check: map[string]string{
+				"id":   "test",
+				"text": "permit(principal, action, resource);",
+			},
+			UpdatedConfig: `
+resource "commonfate_policyset" "test" {
+  id   = "test-updated"
+  text = "forbid(principal, action, resource);"
+}
+`,
+			UpdatedCheck: map[string]string{
+				"id":   "test-updated",
+				"text": "forbid(principal, action, resource);",
+			},
+		},
+	})
+}
+
+func TestAccPolicySet_Invalid(t *testing.T) {
+	s := acctest.NewServer(t)
+
+	for name, tt := range map[string]struct {
+		text        string
+		expectError *regexp.Regexp
+	}{
+		"syntax error": {
+			text:        `permit(principal, action, resource) when { resource.name == "prod" ;`,
+			expectError: regexp.MustCompile(`(?s)Invalid Cedar Policy.*line 1, column 69`),
+		},
+		"unknown action": {
+			text:        `permit(principal, action == Access::Action::"Reqest", resource);`,
+			expectError: regexp.MustCompile(`Unknown Cedar Action`),
+		},
+		"unknown entity type": {
+			text:        `permit(principal is CF::Usr, action, resource);`,
+			expectError: regexp.MustCompile(`Unknown Cedar Entity Type`),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: acctest.ProviderConfig(s) + fmt.Sprintf(`
+resource "commonfate_policyset" "test" {
+  id   = "test"
+  text = %q
+}
+`, tt.text),
+						PlanOnly:    true,
+						ExpectError: tt.expectError,
+					},
+				},
+			})
+		})
+	}
+}
+
+const policiesConfig = `
+resource "commonfate_policyset" "test" {
+  id = "test"
+
+  policies = [
+    {
+      effect = "permit"
+      annotations = {
+        id = "request-prod"
+      }
+      principal = {
+        in = { type = "CF::Group", id = "engineering" }
+      }
+      action = {
+        eq = { type = "Access::Action", id = "Request" }
+      }
+      resource = {
+        is = "AWS::Account"
+      }
+      when = "resource.tags.contains(\"prod\")"
+    },
+    {
+      effect = "forbid"
+      action = {
+        in = [
+          { type = "Access::Action", id = "Activate" },
+          { type = "Access::Action", id = "Extend" },
+        ]
+      }
+      unless = "context.request.reason != \"\""
+    },
+  ]
+}
+`
+
+const policiesText = `@id("request-prod")
+permit (
+    principal in CF::Group::"engineering",
+    action == Access::Action::"Request",
+    resource is AWS::Account
+)
+when { resource.tags.contains("prod") };
+
+forbid (
+    principal,
+    action in [Access::Action::"Activate", Access::Action::"Extend"],
+    resource
+)
+unless { context.request.reason != "" };`
+
+func TestAccPolicySet_Policies(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "policies",
+			Resource: "commonfate_policyset.test",
+			Config:   policiesConfig,
+			Check: map[string]string{
+				"id":                           "test",
+				"text":                         policiesText,
+				"policies.#":                   "2",
+				"policies.0.principal.in.type": "CF::Group",
+			},
+			UpdatedConfig: `
+resource "commonfate_policyset" "test" {
+  id = "test"
+
+  policies = [
+    {
+      effect = "permit"
+      resource = {
+        eq = { type = "AWS::Account", id = "123456789012" }
+      }
+    },
+  ]
+}
+`,
+			UpdatedCheck: map[string]string{
+				"text":       "permit (\n    principal,\n    action,\n    resource == AWS::Account::\"123456789012\"\n);",
+				"policies.#": "1",
+			},
+			// an imported policyset only has its text, as Terraform can't tell whether it was written as policies.
+			ImportStateVerifyIgnore: []string{"policies"},
+		},
+	})
+}
+
+func TestAccPolicySet_PoliciesDrift(t *testing.T) {
+	s := acctest.NewServer(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(s) + policiesConfig,
+			},
+			{
+				// text which is only formatted differently doesn't show a diff.
+				PreConfig: func() {
+					s.PutPolicySet("test", "// reformatted\n"+strings.ReplaceAll(policiesText, "\n    ", " "))
+				},
+				Config:   acctest.ProviderConfig(s) + policiesConfig,
+				PlanOnly: true,
+			},
+			{
+				// a change to the policies is read back into the structured form, and planned to be reverted.
+				PreConfig: func() {
+					s.PutPolicySet("test", `permit(principal, action == Access::Action::"Close", resource);`)
+				},
+				Config:             acctest.ProviderConfig(s) + policiesConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: acctest.ProviderConfig(s) + policiesConfig,
+				Check:  resource.TestCheckResourceAttr("commonfate_policyset.test", "text", policiesText),
+			},
+		},
+	})
+}
+
+func TestAccPolicySet_InvalidPolicies(t *testing.T) {
+	s := acctest.NewServer(t)
+
+	for name, tt := range map[string]struct {
+		policies    string
+		expectError *regexp.Regexp
+	}{
+		"invalid when": {
+			policies:    `{ effect = "permit", when = "resource.name ==" }`,
+			expectError: regexp.MustCompile(`(?s)Invalid Cedar Expression.*line 1`),
+		},
+		"unknown action": {
+			policies:    `{ effect = "permit", action = { eq = { type = "Access::Action", id = "Reqest" } } }`,
+			expectError: regexp.MustCompile(`Unknown Cedar Action`),
+		},
+		"eq conflicts with is": {
+			policies:    `{ effect = "permit", principal = { eq = { type = "CF::User", id = "usr_1" }, is = "CF::User" } }`,
+			expectError: regexp.MustCompile(`Invalid Attribute Combination`),
+		},
+		"invalid effect": {
+			policies:    `{ effect = "allow" }`,
+			expectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: acctest.ProviderConfig(s) + fmt.Sprintf(`
+resource "commonfate_policyset" "test" {
+  id       = "test"
+  policies = [%s]
+}
+`, tt.policies),
+						PlanOnly:    true,
+						ExpectError: tt.expectError,
+					},
+				},
+			})
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(s) + `
+resource "commonfate_policyset" "test" {
+  id       = "test"
+  text     = "permit(principal, action, resource);"
+  policies = [{ effect = "permit" }]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccPolicySet_EquivalentText(t *testing.T) {
+	s := acctest.NewServer(t)
+
+	config := acctest.ProviderConfig(s) + `
+resource "commonfate_policyset" "test" {
+  id   = "test"
+  text = <<EOT
+permit(
+  principal,
+  action == Access::Action::"Request",
+  resource
+);
+EOT
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				// comments and formatting are ignored.
+				PreConfig: func() {
+					s.PutPolicySet("test", "// requests\npermit(principal, action == Access::Action::\"Request\", resource);")
+				},
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					s.PutPolicySet("test", "forbid(principal, action == Access::Action::\"Request\", resource);")
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -3,12 +3,14 @@ package access
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
-	config_client "github.com/common-fate/sdk/config"
 	configv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1"
 	"github.com/common-fate/sdk/service/control/configsvc"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
 	"github.com/common-fate/terraform-provider-commonfate/pkg/eid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,6 +23,7 @@ type WebhookProvisionerModel struct {
 	ID           types.String      `tfsdk:"id"`
 	URL          types.String      `tfsdk:"url"`
 	Capabilities []CapabilityModel `tfsdk:"capabilities"`
+	Timeouts     timeouts.Value    `tfsdk:"timeouts"`
 }
 
 type CapabilityModel struct {
@@ -31,7 +34,9 @@ type CapabilityModel struct {
 
 // AccessRuleResource is the data source implementation.
 type WebhookProvisionerResource struct {
-	client *configsvc.Client
+	client         *configsvc.Client
+	defaultTimeout time.Duration
+	deployment     providerdata.Deployment
 }
 
 var (
@@ -51,15 +56,18 @@ func (r *WebhookProvisionerResource) Configure(_ context.Context, req resource.C
 		return
 	}
 
-	cfg, ok := req.ProviderData.(*config_client.Context)
+	providerData, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
+	cfg := providerData.Config
+	r.defaultTimeout = providerData.DefaultTimeout
+	r.deployment = providerData.Deployment
 	client := configsvc.NewFromConfig(cfg)
 
 	r.client = client
@@ -105,6 +113,14 @@ func (r *WebhookProvisionerResource) Schema(ctx context.Context, req resource.Sc
 			},
 		},
 		MarkdownDescription: `Registers a provisioner with a webhook URL to provision access.`,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -132,6 +148,15 @@ func (r *WebhookProvisionerResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var capabilities []*configv1alpha1.Capability
 
 	for _, c := range data.Capabilities {
@@ -164,6 +189,7 @@ func (r *WebhookProvisionerResource) Create(ctx context.Context, req resource.Cr
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -180,9 +206,26 @@ func (r *WebhookProvisionerResource) Read(ctx context.Context, req resource.Read
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	//read the state from the client
-	_, err := r.client.WebhookProvisioner().GetWebhookProvisioner(ctx, connect.NewRequest(&configv1alpha1.GetWebhookProvisionerRequest{
+	res, err := r.client.WebhookProvisioner().GetWebhookProvisioner(ctx, connect.NewRequest(&configv1alpha1.GetWebhookProvisionerRequest{
 		Id: state.ID.ValueString(),
 	}))
 	if connect.CodeOf(err) == connect.CodeNotFound {
@@ -196,7 +239,18 @@ func (r *WebhookProvisionerResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	state.URL = types.StringValue(res.Msg.WebhookProvisioner.Url)
+	state.Capabilities = []CapabilityModel{}
+	for _, c := range res.Msg.WebhookProvisioner.Capabilities {
+		state.Capabilities = append(state.Capabilities, CapabilityModel{
+			TargetType:  types.StringValue(c.TargetType),
+			RoleType:    types.StringValue(c.RoleType),
+			BelongingTo: eid.EIDFromAPI(c.BelongingTo),
+		})
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *WebhookProvisionerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -219,6 +273,20 @@ func (r *WebhookProvisionerResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var capabilities []*configv1alpha1.Capability
 
 	for _, c := range data.Capabilities {
@@ -252,6 +320,7 @@ func (r *WebhookProvisionerResource) Update(ctx context.Context, req resource.Up
 	data.ID = types.StringValue(res.Msg.WebhookProvisioner.Id)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *WebhookProvisionerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -274,6 +343,20 @@ func (r *WebhookProvisionerResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.WebhookProvisioner().DeleteWebhookProvisioner(ctx, connect.NewRequest(&configv1alpha1.DeleteWebhookProvisionerRequest{
 		Id: data.ID.ValueString(),
 	}))
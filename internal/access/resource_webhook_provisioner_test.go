@@ -0,0 +1,65 @@
+package access_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccWebhookProvisioner(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_webhook_provisioner.test",
+			Config: `
+resource "commonfate_webhook_provisioner" "test" {
+  url = "https://provisioner.example.com"
+  capabilities = [
+    {
+      target_type = "AWS::Account"
+      role_type   = "AWS::IDC::PermissionSet"
+      belonging_to = {
+        type = "AWS::IDC::Instance"
+        id   = "ssoins-123"
+      }
+    },
+  ]
+}
+`,
+			Check: map[string]string{
+				"url":                            "https://provisioner.example.com",
+				"capabilities.#":                 "1",
+				"capabilities.0.target_type":     "AWS::Account",
+				"capabilities.0.belonging_to.id": "ssoins-123",
+			},
+			UpdatedConfig: `
+resource "commonfate_webhook_provisioner" "test" {
+  url = "https://provisioner-updated.example.com"
+  capabilities = [
+    {
+      target_type = "AWS::Account"
+      role_type   = "AWS::IDC::PermissionSet"
+      belonging_to = {
+        type = "AWS::IDC::Instance"
+        id   = "ssoins-123"
+      }
+    },
+    {
+      target_type = "GCP::Project"
+      role_type   = "GCP::Role"
+      belonging_to = {
+        type = "GCP::Organization"
+        id   = "1234"
+      }
+    },
+  ]
+}
+`,
+			UpdatedCheck: map[string]string{
+				"url":                        "https://provisioner-updated.example.com",
+				"capabilities.#":             "2",
+				"capabilities.1.target_type": "GCP::Project",
+			},
+		},
+	})
+}
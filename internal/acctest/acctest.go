@@ -0,0 +1,47 @@
+// Package acctest contains helpers for running the provider's acceptance tests
+// against an in-memory Common Fate API.
+package acctest
+
+import (
+	"fmt"
+	"testing"
+
+	provider "github.com/common-fate/terraform-provider-commonfate/internal"
+	"github.com/common-fate/terraform-provider-commonfate/internal/mockapi"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// ProtoV6ProviderFactories instantiates the Common Fate provider for acceptance testing.
+var ProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"commonfate": providerserver.NewProtocol6WithError(provider.New()),
+}
+
+// NewServer starts an in-memory Common Fate API which is shut down when the test finishes.
+func NewServer(t *testing.T) *mockapi.Server {
+	t.Helper()
+
+	s := mockapi.New()
+	t.Cleanup(s.Close)
+	return s
+}
+
+// ProviderConfig returns a provider block which points the provider at the given server.
+func ProviderConfig(s *mockapi.Server) string {
+	return ProviderConfigWith(s, "")
+}
+
+// ProviderConfigWith returns a provider block which points the provider at the given server,
+// with extra appended to the body of the block.
+func ProviderConfigWith(s *mockapi.Server, extra string) string {
+	return fmt.Sprintf(`
+provider "commonfate" {
+  api_url            = %q
+  authz_url          = %q
+  oidc_issuer        = %q
+  oidc_client_id     = %q
+  oidc_client_secret = %q
+%s
+}
+`, s.URL, s.URL, s.URL, mockapi.ClientID, mockapi.ClientSecret, extra)
+}
@@ -0,0 +1,46 @@
+package acctest
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// DatasourceTest describes a test which reads a single data source.
+type DatasourceTest struct {
+	// Name identifies the test case.
+	Name string
+	// Datasource is the address of the data source under test, e.g. "data.commonfate_okta_integration.test".
+	Datasource string
+	// Config contains the data source block, along with any resources it reads.
+	Config string
+	// Check contains the attribute values expected after Config is applied.
+	Check map[string]string
+	// ExpectError, if set, is matched against the error which reading the data source should fail with.
+	ExpectError *regexp.Regexp
+}
+
+// RunDatasourceTests runs each of the given data source tests against its own in-memory API.
+func RunDatasourceTests(t *testing.T, tests []DatasourceTest) {
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			s := NewServer(t)
+
+			step := resource.TestStep{
+				Config:      ProviderConfig(s) + tt.Config,
+				ExpectError: tt.ExpectError,
+			}
+			if tt.ExpectError == nil {
+				step.Check = checkAttrs(tt.Datasource, tt.Check)
+			}
+
+			resource.ParallelTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+				CheckDestroy:             checkDestroyed(s),
+				Steps:                    []resource.TestStep{step},
+			})
+		})
+	}
+}
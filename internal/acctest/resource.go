@@ -0,0 +1,122 @@
+package acctest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/mockapi"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// ResourceTest describes the lifecycle test of a single resource.
+//
+// Each test creates the resource, imports it, optionally updates it, and then
+// deletes it outside of Terraform to check that the drift is detected.
+type ResourceTest struct {
+	// Name identifies the test case.
+	Name string
+	// Resource is the address of the resource under test, e.g. "commonfate_selector.test".
+	Resource string
+	// Config contains the resource block(s) used to create the resource.
+	Config string
+	// Check contains the attribute values expected after Config is applied.
+	Check map[string]string
+	// UpdatedConfig, if set, is applied after the import step to exercise Update.
+	UpdatedConfig string
+	// UpdatedCheck contains the attribute values expected after UpdatedConfig is applied.
+	UpdatedCheck map[string]string
+	// ImportStateVerifyIgnore lists attributes which are not read back when the resource is imported.
+	ImportStateVerifyIgnore []string
+	// SkipImport skips the import step for resources which don't support being imported.
+	SkipImport bool
+}
+
+// RunResourceTests runs each of the given resource tests against its own in-memory API.
+func RunResourceTests(t *testing.T, tests []ResourceTest) {
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			s := NewServer(t)
+			resource.ParallelTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+				CheckDestroy:             checkDestroyed(s),
+				Steps:                    tt.steps(s),
+			})
+		})
+	}
+}
+
+func (tt ResourceTest) steps(s *mockapi.Server) []resource.TestStep {
+	config := ProviderConfig(s) + tt.Config
+
+	steps := []resource.TestStep{
+		{
+			Config: config,
+			Check:  checkAttrs(tt.Resource, tt.Check),
+		},
+	}
+
+	if !tt.SkipImport {
+		steps = append(steps, resource.TestStep{
+			ResourceName:            tt.Resource,
+			ImportState:             true,
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: tt.ImportStateVerifyIgnore,
+		})
+	}
+
+	if tt.UpdatedConfig != "" {
+		config = ProviderConfig(s) + tt.UpdatedConfig
+		steps = append(steps, resource.TestStep{
+			Config: config,
+			Check:  checkAttrs(tt.Resource, tt.UpdatedCheck),
+		})
+	}
+
+	// remove the resource behind Terraform's back: the next refresh
+	// should drop it from state and plan to create it again.
+	steps = append(steps, resource.TestStep{
+		Config:             config,
+		Check:              removeFromServer(s, tt.Resource),
+		ExpectNonEmptyPlan: true,
+	})
+
+	return steps
+}
+
+func checkAttrs(name string, attrs map[string]string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrSet(name, "id"),
+	}
+	for k, v := range attrs {
+		checks = append(checks, resource.TestCheckResourceAttr(name, k, v))
+	}
+	return resource.ComposeAggregateTestCheckFunc(checks...)
+}
+
+// removeFromServer deletes the object backing the named resource from the server.
+func removeFromServer(s *mockapi.Server, name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", name)
+		}
+		if !s.Remove(rs.Primary.ID) {
+			return fmt.Errorf("%s with ID %q not found in the API", name, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+// checkDestroyed verifies that every resource in state has been deleted from the server.
+func checkDestroyed(s *mockapi.Server) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for name, rs := range state.RootModule().Resources {
+			if s.Exists(rs.Primary.ID) {
+				return fmt.Errorf("%s with ID %q still exists", name, rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
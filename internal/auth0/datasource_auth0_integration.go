@@ -0,0 +1,120 @@
+package auth0
+
+import (
+	"context"
+	"fmt"
+
+	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
+	"github.com/common-fate/sdk/service/control/integration"
+	"github.com/common-fate/terraform-provider-commonfate/internal/integrations"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Auth0IntegrationDatasourceModel struct {
+	Id                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Domain                 types.String `tfsdk:"domain"`
+	ClientID               types.String `tfsdk:"client_id"`
+	ClientSecretSecretPath types.String `tfsdk:"client_secret_secret_path"`
+}
+
+// Auth0IntegrationDatasource looks up an existing Auth0 integration by ID or name.
+type Auth0IntegrationDatasource struct {
+	client integrationv1alpha1connect.IntegrationServiceClient
+}
+
+var (
+	_ datasource.DataSource                     = &Auth0IntegrationDatasource{}
+	_ datasource.DataSourceWithConfigValidators = &Auth0IntegrationDatasource{}
+)
+
+// Metadata returns the data source type name.
+func (r *Auth0IntegrationDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth0_integration"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *Auth0IntegrationDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	cfg := providerData.Config
+
+	r.client = integration.NewFromConfig(cfg)
+}
+
+func (r *Auth0IntegrationDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing Auth0 integration by ID or name.",
+		Attributes: integrations.LookupAttributes("Auth0", map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The Auth0 tenant domain",
+				Computed:            true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The Auth0 application client ID",
+				Computed:            true,
+			},
+			"client_secret_secret_path": schema.StringAttribute{
+				MarkdownDescription: "Path to secret for the Auth0 client secret",
+				Computed:            true,
+			},
+		}),
+	}
+}
+
+func (r *Auth0IntegrationDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return integrations.ConfigValidators()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *Auth0IntegrationDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HTTP Client",
+			"Expected configured HTTP client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data Auth0IntegrationDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integ, diags := integrations.Lookup(ctx, r.client, data.Id, data.Name, "Auth0", func(c *integrationv1alpha1.Config) bool {
+		return c.GetAuth0() != nil
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := integ.Config.GetAuth0()
+
+	state := Auth0IntegrationDatasourceModel{
+		Id:                     types.StringValue(integ.Id),
+		Name:                   types.StringValue(integ.Name),
+		Domain:                 types.StringValue(config.Domain),
+		ClientID:               types.StringValue(config.ClientId),
+		ClientSecretSecretPath: types.StringValue(config.ClientSecretSecretPath),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
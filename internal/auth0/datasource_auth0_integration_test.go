@@ -0,0 +1,63 @@
+package auth0_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+const auth0IntegrationDatasourceResource = `
+resource "commonfate_auth0_integration" "test" {
+  name                      = "test"
+  domain                    = "example.auth0.com"
+  client_id                 = "client"
+  client_secret_secret_path = "/secret"
+}
+`
+
+func TestAccAuth0IntegrationDatasource(t *testing.T) {
+	acctest.RunDatasourceTests(t, []acctest.DatasourceTest{
+		{
+			Name:       "by_id",
+			Datasource: "data.commonfate_auth0_integration.test",
+			Config: auth0IntegrationDatasourceResource + `
+data "commonfate_auth0_integration" "test" {
+  id = commonfate_auth0_integration.test.id
+}
+`,
+			Check: map[string]string{
+				"name":                      "test",
+				"domain":                    "example.auth0.com",
+				"client_id":                 "client",
+				"client_secret_secret_path": "/secret",
+			},
+		},
+		{
+			Name:       "by_name",
+			Datasource: "data.commonfate_auth0_integration.test",
+			Config: auth0IntegrationDatasourceResource + `
+data "commonfate_auth0_integration" "test" {
+  name = "test"
+
+  depends_on = [commonfate_auth0_integration.test]
+}
+`,
+			Check: map[string]string{
+				"name":                      "test",
+				"domain":                    "example.auth0.com",
+				"client_id":                 "client",
+				"client_secret_secret_path": "/secret",
+			},
+		},
+		{
+			Name: "not_found",
+			Config: `
+data "commonfate_auth0_integration" "test" {
+  name = "missing"
+}
+`,
+			ExpectError: regexp.MustCompile(`Auth0 Integration Not Found`),
+		},
+	})
+}
@@ -3,13 +3,15 @@ package auth0
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
-	config_client "github.com/common-fate/sdk/config"
 	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
 	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
 	"github.com/common-fate/sdk/service/control/integration"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
 	"github.com/common-fate/terraform-provider-commonfate/pkg/diags"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,15 +21,18 @@ import (
 )
 
 type Auth0IntegrationModel struct {
-	Id                     types.String `tfsdk:"id"`
-	Name                   types.String `tfsdk:"name"`
-	Domain                 types.String `tfsdk:"domain"`
-	ClientID               types.String `tfsdk:"client_id"`
-	ClientSecretSecretPath types.String `tfsdk:"client_secret_secret_path"`
+	Id                     types.String   `tfsdk:"id"`
+	Name                   types.String   `tfsdk:"name"`
+	Domain                 types.String   `tfsdk:"domain"`
+	ClientID               types.String   `tfsdk:"client_id"`
+	ClientSecretSecretPath types.String   `tfsdk:"client_secret_secret_path"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
 }
 
 type Auth0IntegrationResource struct {
-	client integrationv1alpha1connect.IntegrationServiceClient
+	client         integrationv1alpha1connect.IntegrationServiceClient
+	defaultTimeout time.Duration
+	deployment     providerdata.Deployment
 }
 
 var (
@@ -47,15 +52,18 @@ func (r *Auth0IntegrationResource) Configure(_ context.Context, req resource.Con
 		return
 	}
 
-	cfg, ok := req.ProviderData.(*config_client.Context)
+	providerData, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
+	cfg := providerData.Config
+	r.defaultTimeout = providerData.DefaultTimeout
+	r.deployment = providerData.Deployment
 	client := integration.NewFromConfig(cfg)
 
 	r.client = client
@@ -93,6 +101,14 @@ func (r *Auth0IntegrationResource) Schema(ctx context.Context, req resource.Sche
 			},
 		},
 		MarkdownDescription: `Registers an Auth0 integration`,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -120,6 +136,15 @@ func (r *Auth0IntegrationResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	res, err := r.client.CreateIntegration(ctx, connect.NewRequest(&integrationv1alpha1.CreateIntegrationRequest{
 		Name: data.Name.ValueString(),
 		Config: &integrationv1alpha1.Config{
@@ -149,6 +174,7 @@ func (r *Auth0IntegrationResource) Create(ctx context.Context, req resource.Crea
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -165,6 +191,23 @@ func (r *Auth0IntegrationResource) Read(ctx context.Context, req resource.ReadRe
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	//read the state from the client
 	res, err := r.client.GetIntegration(ctx, connect.NewRequest(&integrationv1alpha1.GetIntegrationRequest{
@@ -192,6 +235,7 @@ func (r *Auth0IntegrationResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	state = Auth0IntegrationModel{
+		Timeouts:               state.Timeouts,
 		Id:                     types.StringValue(state.Id.ValueString()),
 		Name:                   types.StringValue(res.Msg.Integration.Name),
 		ClientID:               types.StringValue(integ.ClientId),
@@ -200,6 +244,7 @@ func (r *Auth0IntegrationResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *Auth0IntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -222,6 +267,20 @@ func (r *Auth0IntegrationResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	res, err := r.client.UpdateIntegration(ctx, connect.NewRequest(&integrationv1alpha1.UpdateIntegrationRequest{
 		Integration: &integrationv1alpha1.Integration{
 			Id:   data.Id.ValueString(),
@@ -254,6 +313,7 @@ func (r *Auth0IntegrationResource) Update(ctx context.Context, req resource.Upda
 	data.Id = types.StringValue(res.Msg.Integration.Id)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *Auth0IntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -276,6 +336,20 @@ func (r *Auth0IntegrationResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.DeleteIntegration(ctx, connect.NewRequest(&integrationv1alpha1.DeleteIntegrationRequest{
 		Id: data.Id.ValueString(),
 	}))
@@ -0,0 +1,42 @@
+package auth0_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccAuth0Integration(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_auth0_integration.test",
+			Config: `
+resource "commonfate_auth0_integration" "test" {
+  name                      = "test"
+  domain                    = "example.auth0.com"
+  client_id                 = "client"
+  client_secret_secret_path = "/secret"
+}
+`,
+			Check: map[string]string{
+				"name":                      "test",
+				"domain":                    "example.auth0.com",
+				"client_id":                 "client",
+				"client_secret_secret_path": "/secret",
+			},
+			UpdatedConfig: `
+resource "commonfate_auth0_integration" "test" {
+  name                      = "test-updated"
+  domain                    = "example.auth0.com"
+  client_id                 = "client-updated"
+  client_secret_secret_path = "/secret"
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name":      "test-updated",
+				"client_id": "client-updated",
+			},
+		},
+	})
+}
@@ -3,12 +3,14 @@ package auth0
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
-	config_client "github.com/common-fate/sdk/config"
 	configv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1"
 	entityv1alpha1 "github.com/common-fate/sdk/gen/commonfate/entity/v1alpha1"
 	"github.com/common-fate/sdk/service/control/configsvc"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,16 +20,19 @@ import (
 )
 
 type Auth0OrganizationAvailabilities struct {
-	ID                          types.String `tfsdk:"id"`
-	WorkflowID                  types.String `tfsdk:"workflow_id"`
-	Auth0OrganizationSelectorID types.String `tfsdk:"auth0_organization_selector_id"`
-	Auth0TenantID               types.String `tfsdk:"auth0_tenant_id"`
-	Role                        types.String `tfsdk:"role"`
-	RolePriority                types.Int64  `tfsdk:"role_priority"`
+	ID                          types.String   `tfsdk:"id"`
+	WorkflowID                  types.String   `tfsdk:"workflow_id"`
+	Auth0OrganizationSelectorID types.String   `tfsdk:"auth0_organization_selector_id"`
+	Auth0TenantID               types.String   `tfsdk:"auth0_tenant_id"`
+	Role                        types.String   `tfsdk:"role"`
+	RolePriority                types.Int64    `tfsdk:"role_priority"`
+	Timeouts                    timeouts.Value `tfsdk:"timeouts"`
 }
 
 type Auth0OrganizationAvailabilitiesResource struct {
-	client *configsvc.Client
+	client         *configsvc.Client
+	defaultTimeout time.Duration
+	deployment     providerdata.Deployment
 }
 
 var (
@@ -47,15 +52,18 @@ func (r *Auth0OrganizationAvailabilitiesResource) Configure(_ context.Context, r
 		return
 	}
 
-	cfg, ok := req.ProviderData.(*config_client.Context)
+	providerData, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
+	cfg := providerData.Config
+	r.defaultTimeout = providerData.DefaultTimeout
+	r.deployment = providerData.Deployment
 	client := configsvc.NewFromConfig(cfg)
 
 	r.client = client
@@ -97,6 +105,14 @@ func (r *Auth0OrganizationAvailabilitiesResource) Schema(ctx context.Context, re
 			},
 		},
 		MarkdownDescription: `A specifier to make Auth0 Organizations available for selection under a particular Access Workflow`,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -124,6 +140,15 @@ func (r *Auth0OrganizationAvailabilitiesResource) Create(ctx context.Context, re
 		return
 	}
 
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	input := &configv1alpha1.CreateAvailabilitySpecRequest{
 		Role: &entityv1alpha1.EID{
 			Type: "Auth0::Role",
@@ -159,6 +184,7 @@ func (r *Auth0OrganizationAvailabilitiesResource) Create(ctx context.Context, re
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -175,6 +201,23 @@ func (r *Auth0OrganizationAvailabilitiesResource) Read(ctx context.Context, req
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	//read the state from the client
 	res, err := r.client.AvailabilitySpec().GetAvailabilitySpec(ctx, connect.NewRequest(&configv1alpha1.GetAvailabilitySpecRequest{
@@ -194,9 +237,27 @@ func (r *Auth0OrganizationAvailabilitiesResource) Read(ctx context.Context, req
 
 	state.ID = types.StringValue(res.Msg.AvailabilitySpec.Id)
 	state.WorkflowID = types.StringValue(res.Msg.AvailabilitySpec.WorkflowId)
+	state.RolePriority = types.Int64PointerValue(res.Msg.AvailabilitySpec.RolePriority)
+	state.Role = types.StringValue(res.Msg.AvailabilitySpec.Role.Id)
 	state.Auth0OrganizationSelectorID = types.StringValue(res.Msg.AvailabilitySpec.Target.Id)
 
+	// the tenant isn't stored on the availability spec, so it's read from the selector the organizations belong to.
+	selector, err := r.client.Selector().GetSelector(ctx, connect.NewRequest(&configv1alpha1.GetSelectorRequest{
+		Id: res.Msg.AvailabilitySpec.Target.Id,
+	}))
+	if err != nil && connect.CodeOf(err) != connect.CodeNotFound {
+		resp.Diagnostics.AddError(
+			"Failed to read Auth0 Organization Selector",
+			err.Error(),
+		)
+		return
+	}
+	if err == nil && selector.Msg.Selector.BelongingTo.GetType() == "Auth0::Tenant" {
+		state.Auth0TenantID = types.StringValue(selector.Msg.Selector.BelongingTo.Id)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *Auth0OrganizationAvailabilitiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -219,6 +280,20 @@ func (r *Auth0OrganizationAvailabilitiesResource) Update(ctx context.Context, re
 		return
 	}
 
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	input := &configv1alpha1.AvailabilitySpec{
 		Id: data.ID.ValueString(),
 		Role: &entityv1alpha1.EID{
@@ -266,6 +341,7 @@ func (r *Auth0OrganizationAvailabilitiesResource) Update(ctx context.Context, re
 	data.ID = types.StringValue(res.Msg.AvailabilitySpec.Id)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *Auth0OrganizationAvailabilitiesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -288,6 +364,20 @@ func (r *Auth0OrganizationAvailabilitiesResource) Delete(ctx context.Context, re
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.AvailabilitySpec().DeleteAvailabilitySpec(ctx, connect.NewRequest(&configv1alpha1.DeleteAvailabilitySpecRequest{
 		Id: data.ID.ValueString(),
 	}))
@@ -0,0 +1,57 @@
+package auth0_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccAuth0OrganizationAvailabilities(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_auth0_organization_availabilities.test",
+			Config: `
+resource "commonfate_auth0_organization_selector" "test" {
+  id              = "sel_123"
+  name            = "All organizations"
+  auth0_tenant_id = "example.auth0.com"
+  when            = "true"
+}
+
+resource "commonfate_auth0_organization_availabilities" "test" {
+  workflow_id                    = "aw_123"
+  auth0_organization_selector_id = commonfate_auth0_organization_selector.test.id
+  auth0_tenant_id                = "example.auth0.com"
+  role                           = "admin"
+}
+`,
+			Check: map[string]string{
+				"workflow_id":                    "aw_123",
+				"auth0_organization_selector_id": "sel_123",
+				"auth0_tenant_id":                "example.auth0.com",
+				"role":                           "admin",
+			},
+			UpdatedConfig: `
+resource "commonfate_auth0_organization_selector" "test" {
+  id              = "sel_123"
+  name            = "All organizations"
+  auth0_tenant_id = "example.auth0.com"
+  when            = "true"
+}
+
+resource "commonfate_auth0_organization_availabilities" "test" {
+  workflow_id                    = "aw_456"
+  auth0_organization_selector_id = commonfate_auth0_organization_selector.test.id
+  auth0_tenant_id                = "example.auth0.com"
+  role                           = "admin"
+  role_priority                  = 10
+}
+`,
+			UpdatedCheck: map[string]string{
+				"workflow_id":   "aw_456",
+				"role_priority": "10",
+			},
+		},
+	})
+}
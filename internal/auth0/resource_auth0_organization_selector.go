@@ -3,13 +3,16 @@ package auth0
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
-	config_client "github.com/common-fate/sdk/config"
 	configv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1"
 	entityv1alpha1 "github.com/common-fate/sdk/gen/commonfate/entity/v1alpha1"
 	"github.com/common-fate/sdk/service/control/configsvc"
+	"github.com/common-fate/terraform-provider-commonfate/internal/cedarpolicy"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
 	"github.com/common-fate/terraform-provider-commonfate/pkg/diags"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,10 +20,11 @@ import (
 )
 
 type Auth0OrganizationSelector struct {
-	ID       types.String `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	TenantID types.String `tfsdk:"auth0_tenant_id"`
-	When     types.String `tfsdk:"when"`
+	ID       types.String           `tfsdk:"id"`
+	Name     types.String           `tfsdk:"name"`
+	TenantID types.String           `tfsdk:"auth0_tenant_id"`
+	When     cedarpolicy.Expression `tfsdk:"when"`
+	Timeouts timeouts.Value         `tfsdk:"timeouts"`
 }
 
 func (s Auth0OrganizationSelector) ToAPI() *configv1alpha1.Selector {
@@ -38,7 +42,9 @@ func (s Auth0OrganizationSelector) ToAPI() *configv1alpha1.Selector {
 
 // AccessRuleResource is the data source implementation.
 type Auth0OrganizationSelectorResource struct {
-	client *configsvc.Client
+	client         *configsvc.Client
+	defaultTimeout time.Duration
+	deployment     providerdata.Deployment
 }
 
 var (
@@ -58,15 +64,18 @@ func (r *Auth0OrganizationSelectorResource) Configure(_ context.Context, req res
 		return
 	}
 
-	cfg, ok := req.ProviderData.(*config_client.Context)
+	providerData, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
+	cfg := providerData.Config
+	r.defaultTimeout = providerData.DefaultTimeout
+	r.deployment = providerData.Deployment
 	client := configsvc.NewFromConfig(cfg)
 
 	r.client = client
@@ -97,9 +106,18 @@ func (r *Auth0OrganizationSelectorResource) Schema(ctx context.Context, req reso
 			"when": schema.StringAttribute{
 				MarkdownDescription: "A Cedar expression with the criteria to match groups on, e.g: `resource.name like \"*production*\"`",
 				Required:            true,
+				CustomType:          cedarpolicy.ExpressionType{},
 			},
 		},
 		MarkdownDescription: `A Selector to match Auth0 Organizations with a criteria based on the 'when' field.`,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -127,6 +145,15 @@ func (r *Auth0OrganizationSelectorResource) Create(ctx context.Context, req reso
 		return
 	}
 
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	res, err := r.client.Selector().CreateSelector(ctx, connect.NewRequest(&configv1alpha1.CreateSelectorRequest{
 		Selector: data.ToAPI(),
 	}))
@@ -150,6 +177,7 @@ func (r *Auth0OrganizationSelectorResource) Create(ctx context.Context, req reso
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -166,6 +194,23 @@ func (r *Auth0OrganizationSelectorResource) Read(ctx context.Context, req resour
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	//read the state from the client
 	res, err := r.client.Selector().GetSelector(ctx, connect.NewRequest(&configv1alpha1.GetSelectorRequest{
@@ -185,9 +230,10 @@ func (r *Auth0OrganizationSelectorResource) Read(ctx context.Context, req resour
 
 	state.Name = types.StringValue(res.Msg.Selector.Name)
 	state.TenantID = types.StringValue(res.Msg.Selector.BelongingTo.Id)
-	state.When = types.StringValue(res.Msg.Selector.When)
+	state.When = cedarpolicy.NewExpressionValue(res.Msg.Selector.When)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *Auth0OrganizationSelectorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -210,6 +256,20 @@ func (r *Auth0OrganizationSelectorResource) Update(ctx context.Context, req reso
 		return
 	}
 
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	res, err := r.client.Selector().UpdateSelector(ctx, connect.NewRequest(&configv1alpha1.UpdateSelectorRequest{
 		Selector: data.ToAPI(),
 	}))
@@ -231,6 +291,7 @@ func (r *Auth0OrganizationSelectorResource) Update(ctx context.Context, req reso
 	data.ID = types.StringValue(res.Msg.Selector.Id)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *Auth0OrganizationSelectorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -253,6 +314,20 @@ func (r *Auth0OrganizationSelectorResource) Delete(ctx context.Context, req reso
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.Selector().DeleteSelector(ctx, connect.NewRequest(&configv1alpha1.DeleteSelectorRequest{
 		Id: data.ID.ValueString(),
 	}))
@@ -0,0 +1,42 @@
+package auth0_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccAuth0OrganizationSelector(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_auth0_organization_selector.test",
+			Config: `
+resource "commonfate_auth0_organization_selector" "test" {
+  id              = "test"
+  name            = "Test"
+  auth0_tenant_id = "example.auth0.com"
+  when            = "resource.name like \"*prod*\""
+}
+`,
+			Check: map[string]string{
+				"id":              "test",
+				"name":            "Test",
+				"auth0_tenant_id": "example.auth0.com",
+				"when":            "resource.name like \"*prod*\"",
+			},
+			UpdatedConfig: `
+resource "commonfate_auth0_organization_selector" "test" {
+  id              = "test"
+  name            = "Test Updated"
+  auth0_tenant_id = "example.auth0.com"
+  when            = "true"
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name": "Test Updated",
+				"when": "true",
+			},
+		},
+	})
+}
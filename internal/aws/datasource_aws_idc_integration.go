@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
+	"github.com/common-fate/sdk/service/control/integration"
+	"github.com/common-fate/terraform-provider-commonfate/internal/integrations"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type AWSIDCIntegrationDatasourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	SSOInstanceARN     types.String `tfsdk:"sso_instance_arn"`
+	IdentityStoreID    types.String `tfsdk:"identity_store_id"`
+	SSORegion          types.String `tfsdk:"sso_region"`
+	ReaderRoleARN      types.String `tfsdk:"reader_role_arn"`
+	AuditRoleName      types.String `tfsdk:"audit_role_name"`
+	ResourceRegions    types.Set    `tfsdk:"resource_regions"`
+	SSOAccessPortalURL types.String `tfsdk:"sso_access_portal_url"`
+	ProvisionerRoleARN types.String `tfsdk:"provisioner_role_arn"`
+}
+
+// AWSIDCIntegrationDatasource looks up an existing AWS IAM Identity Center integration by ID or name.
+type AWSIDCIntegrationDatasource struct {
+	client integrationv1alpha1connect.IntegrationServiceClient
+}
+
+var (
+	_ datasource.DataSource                     = &AWSIDCIntegrationDatasource{}
+	_ datasource.DataSourceWithConfigValidators = &AWSIDCIntegrationDatasource{}
+)
+
+// Metadata returns the data source type name.
+func (r *AWSIDCIntegrationDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_aws_idc_integration"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *AWSIDCIntegrationDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	cfg := providerData.Config
+
+	r.client = integration.NewFromConfig(cfg)
+}
+
+func (r *AWSIDCIntegrationDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing AWS IAM Identity Center integration by ID or name.",
+		Attributes: integrations.LookupAttributes("AWS IAM Identity Center", map[string]schema.Attribute{
+			"sso_instance_arn": schema.StringAttribute{
+				MarkdownDescription: "The ARN of the IAM Identity Center SSO instance",
+				Computed:            true,
+			},
+			"identity_store_id": schema.StringAttribute{
+				MarkdownDescription: "The IAM Identity Center identity store ID",
+				Computed:            true,
+			},
+			"sso_region": schema.StringAttribute{
+				MarkdownDescription: "The AWS region that the SSO instance is hosted in",
+				Computed:            true,
+			},
+			"reader_role_arn": schema.StringAttribute{
+				MarkdownDescription: "The ARN of the role to assume in order to read AWS IAM Identity Center data",
+				Computed:            true,
+			},
+			"audit_role_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the role to assume in each AWS Account in order to read resources",
+				Computed:            true,
+			},
+			"resource_regions": schema.SetAttribute{
+				MarkdownDescription: "The regions to read resources from in each account",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"sso_access_portal_url": schema.StringAttribute{
+				MarkdownDescription: "The SSO access portal URL, e.g https://example.awsapps.com/start",
+				Computed:            true,
+			},
+			"provisioner_role_arn": schema.StringAttribute{
+				MarkdownDescription: "The ARN of the role to assume in order to provision access in AWS IAM Identity Center",
+				Computed:            true,
+			},
+		}),
+	}
+}
+
+func (r *AWSIDCIntegrationDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return integrations.ConfigValidators()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *AWSIDCIntegrationDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HTTP Client",
+			"Expected configured HTTP client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data AWSIDCIntegrationDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integ, diags := integrations.Lookup(ctx, r.client, data.Id, data.Name, "AWS IAM Identity Center", func(c *integrationv1alpha1.Config) bool {
+		return c.GetAwsIdc() != nil
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := integ.Config.GetAwsIdc()
+
+	resourceRegions, diags := types.SetValueFrom(ctx, types.StringType, config.ResourceRegions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := AWSIDCIntegrationDatasourceModel{
+		Id:                 types.StringValue(integ.Id),
+		Name:               types.StringValue(integ.Name),
+		SSOInstanceARN:     types.StringValue(config.SsoInstanceArn),
+		IdentityStoreID:    types.StringValue(config.IdentityStoreId),
+		SSORegion:          types.StringValue(config.SsoRegion),
+		ReaderRoleARN:      types.StringValue(config.ReaderRoleArn),
+		AuditRoleName:      types.StringValue(config.AuditRoleName),
+		ResourceRegions:    resourceRegions,
+		SSOAccessPortalURL: types.StringValue(config.SsoAccessPortalUrl),
+		ProvisionerRoleARN: types.StringValue(config.ProvisionerRoleArn),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,66 @@
+package aws_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+const awsidcIntegrationDatasourceResource = `
+resource "commonfate_aws_idc_integration" "test" {
+  name              = "test"
+  reader_role_arn   = "arn:aws:iam::123456789012:role/reader"
+  sso_instance_arn  = "arn:aws:sso:::instance/ssoins-123"
+  identity_store_id = "d-123"
+  sso_region        = "us-east-1"
+}
+`
+
+func TestAccAWSIDCIntegrationDatasource(t *testing.T) {
+	acctest.RunDatasourceTests(t, []acctest.DatasourceTest{
+		{
+			Name:       "by_id",
+			Datasource: "data.commonfate_aws_idc_integration.test",
+			Config: awsidcIntegrationDatasourceResource + `
+data "commonfate_aws_idc_integration" "test" {
+  id = commonfate_aws_idc_integration.test.id
+}
+`,
+			Check: map[string]string{
+				"name":              "test",
+				"reader_role_arn":   "arn:aws:iam::123456789012:role/reader",
+				"sso_instance_arn":  "arn:aws:sso:::instance/ssoins-123",
+				"identity_store_id": "d-123",
+				"sso_region":        "us-east-1",
+			},
+		},
+		{
+			Name:       "by_name",
+			Datasource: "data.commonfate_aws_idc_integration.test",
+			Config: awsidcIntegrationDatasourceResource + `
+data "commonfate_aws_idc_integration" "test" {
+  name = "test"
+
+  depends_on = [commonfate_aws_idc_integration.test]
+}
+`,
+			Check: map[string]string{
+				"name":              "test",
+				"reader_role_arn":   "arn:aws:iam::123456789012:role/reader",
+				"sso_instance_arn":  "arn:aws:sso:::instance/ssoins-123",
+				"identity_store_id": "d-123",
+				"sso_region":        "us-east-1",
+			},
+		},
+		{
+			Name: "not_found",
+			Config: `
+data "commonfate_aws_idc_integration" "test" {
+  name = "missing"
+}
+`,
+			ExpectError: regexp.MustCompile(`AWS IAM Identity Center Integration Not Found`),
+		},
+	})
+}
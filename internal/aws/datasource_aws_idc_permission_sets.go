@@ -0,0 +1,185 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/common-fate/grab"
+	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
+	entityv1alpha1 "github.com/common-fate/sdk/gen/commonfate/entity/v1alpha1"
+	"github.com/common-fate/sdk/service/control/integration"
+	"github.com/common-fate/sdk/service/entity"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type AWSIDCPermissionSetsModel struct {
+	IntegrationID  types.String          `tfsdk:"aws_idc_integration_id"`
+	PermissionSets []AWSIDCPermissionSet `tfsdk:"permission_sets"`
+}
+
+type AWSIDCPermissionSet struct {
+	Name        types.String `tfsdk:"name"`
+	ARN         types.String `tfsdk:"arn"`
+	Description types.String `tfsdk:"description"`
+}
+
+// AWSIDCPermissionSetsDatasource lists the permission sets which have been synced by an AWS IAM Identity Center integration.
+type AWSIDCPermissionSetsDatasource struct {
+	client   integrationv1alpha1connect.IntegrationServiceClient
+	entities entity.Client
+}
+
+var _ datasource.DataSource = &AWSIDCPermissionSetsDatasource{}
+
+// Metadata returns the data source type name.
+func (r *AWSIDCPermissionSetsDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_aws_idc_permission_sets"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *AWSIDCPermissionSetsDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	cfg := providerData.Config
+
+	r.client = integration.NewFromConfig(cfg)
+	r.entities = entity.NewFromConfig(cfg)
+}
+
+func (r *AWSIDCPermissionSetsDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the permission sets which Common Fate has synced from an AWS IAM Identity Center integration. Use it to build `commonfate_aws_idc_account_availabilities` resources without copying permission set ARNs from the AWS console.",
+		Attributes: map[string]schema.Attribute{
+			"aws_idc_integration_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the `commonfate_aws_idc_integration` to list permission sets for.",
+				Required:            true,
+			},
+			"permission_sets": schema.ListNestedAttribute{
+				MarkdownDescription: "The permission sets in the integration's SSO instance, ordered by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the permission set.",
+							Computed:            true,
+						},
+						"arn": schema.StringAttribute{
+							MarkdownDescription: "The ARN of the permission set, for use as `aws_permission_set_arn`.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the permission set, if it has one.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *AWSIDCPermissionSetsDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HTTP Client",
+			"Expected configured HTTP client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var state AWSIDCPermissionSetsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.GetIntegration(ctx, connect.NewRequest(&integrationv1alpha1.GetIntegrationRequest{
+		Id: state.IntegrationID.ValueString(),
+	}))
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		resp.Diagnostics.AddAttributeError(path.Root("aws_idc_integration_id"), "AWS IAM Identity Center Integration Not Found", fmt.Sprintf("No integration with ID %q exists.", state.IntegrationID.ValueString()))
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read AWS IAM Identity Center Integration",
+			err.Error(),
+		)
+		return
+	}
+
+	integ := res.Msg.Integration.Config.GetAwsIdc()
+	if integ == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("aws_idc_integration_id"), "Wrong Integration Type", fmt.Sprintf("Integration %q exists, but it is not of type AWS IAM Identity Center.", state.IntegrationID.ValueString()))
+		return
+	}
+
+	all, err := r.entities.All(ctx, entity.ListInput{Type: "AWS::IDC::PermissionSet"})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list AWS IAM Identity Center Permission Sets",
+			err.Error(),
+		)
+		return
+	}
+
+	// permission set ARNs contain the ID of their SSO instance, e.g.
+	// arn:aws:sso:::permissionSet/ssoins-1234567890abcdef/ps-1234567890abcdef,
+	// which is how the permission sets synced by this integration are told apart from any others.
+	instanceID := integ.SsoInstanceArn[strings.LastIndex(integ.SsoInstanceArn, "/")+1:]
+
+	state.PermissionSets = []AWSIDCPermissionSet{}
+	for _, e := range all {
+		if !strings.Contains(e.Eid.Id, ":permissionSet/"+instanceID+"/") {
+			continue
+		}
+
+		name := stringAttr(e, "name")
+		if name == "" {
+			name = e.Eid.Id
+		}
+		description := stringAttr(e, "description")
+
+		state.PermissionSets = append(state.PermissionSets, AWSIDCPermissionSet{
+			Name:        types.StringValue(name),
+			ARN:         types.StringValue(e.Eid.Id),
+			Description: types.StringPointerValue(grab.If(description == "", nil, &description)),
+		})
+	}
+
+	sort.SliceStable(state.PermissionSets, func(i, j int) bool {
+		return state.PermissionSets[i].Name.ValueString() < state.PermissionSets[j].Name.ValueString()
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// stringAttr returns the value of a string attribute of the entity, or an empty string if it isn't set.
+func stringAttr(e *entityv1alpha1.Entity, key string) string {
+	for _, a := range e.Attributes {
+		if a.Key == key {
+			return a.Value.GetStr()
+		}
+	}
+	return ""
+}
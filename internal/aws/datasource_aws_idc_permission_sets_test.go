@@ -0,0 +1,90 @@
+package aws_test
+
+import (
+	"regexp"
+	"testing"
+
+	entityv1alpha1 "github.com/common-fate/sdk/gen/commonfate/entity/v1alpha1"
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func permissionSet(arn string, attrs map[string]string) *entityv1alpha1.Entity {
+	e := &entityv1alpha1.Entity{Eid: &entityv1alpha1.EID{Type: "AWS::IDC::PermissionSet", Id: arn}}
+	for k, v := range attrs {
+		e.Attributes = append(e.Attributes, &entityv1alpha1.Attribute{
+			Key:   k,
+			Value: &entityv1alpha1.Value{Value: &entityv1alpha1.Value_Str{Str: v}},
+		})
+	}
+	return e
+}
+
+func TestAccAWSIDCPermissionSetsDatasource(t *testing.T) {
+	s := acctest.NewServer(t)
+
+	s.PutEntity(permissionSet("arn:aws:sso:::permissionSet/ssoins-123/ps-readonly", map[string]string{"name": "ReadOnlyAccess"}))
+	s.PutEntity(permissionSet("arn:aws:sso:::permissionSet/ssoins-123/ps-admin", map[string]string{"name": "AdministratorAccess", "description": "Full access"}))
+	s.PutEntity(permissionSet("arn:aws:sso:::permissionSet/ssoins-123/ps-billing", map[string]string{"name": "Billing"}))
+	// belongs to a different SSO instance.
+	s.PutEntity(permissionSet("arn:aws:sso:::permissionSet/ssoins-456/ps-other", map[string]string{"name": "Other"}))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(s) + `
+resource "commonfate_aws_idc_integration" "test" {
+  name              = "test"
+  reader_role_arn   = "arn:aws:iam::123456789012:role/reader"
+  sso_instance_arn  = "arn:aws:sso:::instance/ssoins-123"
+  identity_store_id = "d-123"
+  sso_region        = "us-east-1"
+}
+
+data "commonfate_aws_idc_permission_sets" "test" {
+  aws_idc_integration_id = commonfate_aws_idc_integration.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.commonfate_aws_idc_permission_sets.test", "permission_sets.#", "3"),
+					resource.TestCheckResourceAttr("data.commonfate_aws_idc_permission_sets.test", "permission_sets.0.name", "AdministratorAccess"),
+					resource.TestCheckResourceAttr("data.commonfate_aws_idc_permission_sets.test", "permission_sets.0.arn", "arn:aws:sso:::permissionSet/ssoins-123/ps-admin"),
+					resource.TestCheckResourceAttr("data.commonfate_aws_idc_permission_sets.test", "permission_sets.0.description", "Full access"),
+					resource.TestCheckResourceAttr("data.commonfate_aws_idc_permission_sets.test", "permission_sets.1.name", "Billing"),
+					resource.TestCheckNoResourceAttr("data.commonfate_aws_idc_permission_sets.test", "permission_sets.1.description"),
+					resource.TestCheckResourceAttr("data.commonfate_aws_idc_permission_sets.test", "permission_sets.2.name", "ReadOnlyAccess"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIDCPermissionSetsDatasource_WrongIntegration(t *testing.T) {
+	acctest.RunDatasourceTests(t, []acctest.DatasourceTest{
+		{
+			Name: "not found",
+			Config: `
+data "commonfate_aws_idc_permission_sets" "test" {
+  aws_idc_integration_id = "int_missing"
+}
+`,
+			ExpectError: regexp.MustCompile(`AWS IAM Identity Center Integration Not Found`),
+		},
+		{
+			Name: "wrong type",
+			Config: `
+resource "commonfate_okta_integration" "test" {
+  name                = "test"
+  organization_id     = "example"
+  api_key_secret_path = "/secret"
+}
+
+data "commonfate_aws_idc_permission_sets" "test" {
+  aws_idc_integration_id = commonfate_okta_integration.test.id
+}
+`,
+			ExpectError: regexp.MustCompile(`Wrong Integration Type`),
+		},
+	})
+}
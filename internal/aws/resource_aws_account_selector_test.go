@@ -0,0 +1,42 @@
+package aws_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccAWSAccountSelector(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_aws_account_selector.test",
+			Config: `
+resource "commonfate_aws_account_selector" "test" {
+  id                  = "test"
+  name                = "Test"
+  aws_organization_id = "o-123"
+  when                = "resource.tag_keys contains \"production\""
+}
+`,
+			Check: map[string]string{
+				"id":                  "test",
+				"name":                "Test",
+				"aws_organization_id": "o-123",
+				"when":                "resource.tag_keys contains \"production\"",
+			},
+			UpdatedConfig: `
+resource "commonfate_aws_account_selector" "test" {
+  id                  = "test"
+  name                = "Test Updated"
+  aws_organization_id = "o-123"
+  when                = "true"
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name": "Test Updated",
+				"when": "true",
+			},
+		},
+	})
+}
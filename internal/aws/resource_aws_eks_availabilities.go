@@ -3,12 +3,14 @@ package aws
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
-	config_client "github.com/common-fate/sdk/config"
 	configv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1"
 	entityv1alpha1 "github.com/common-fate/sdk/gen/commonfate/entity/v1alpha1"
 	"github.com/common-fate/sdk/service/control/configsvc"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,16 +20,19 @@ import (
 )
 
 type AWSEKSAvailabilities struct {
-	ID                     types.String `tfsdk:"id"`
-	WorkflowID             types.String `tfsdk:"workflow_id"`
-	AWSEKSServiceAccountID types.String `tfsdk:"aws_eks_service_account_id"`
-	AWSEKSSelectorID       types.String `tfsdk:"aws_eks_selector_id"`
-	AWSIdentityStoreID     types.String `tfsdk:"aws_identity_store_id"`
-	RolePriority           types.Int64  `tfsdk:"role_priority"`
+	ID                     types.String   `tfsdk:"id"`
+	WorkflowID             types.String   `tfsdk:"workflow_id"`
+	AWSEKSServiceAccountID types.String   `tfsdk:"aws_eks_service_account_id"`
+	AWSEKSSelectorID       types.String   `tfsdk:"aws_eks_selector_id"`
+	AWSIdentityStoreID     types.String   `tfsdk:"aws_identity_store_id"`
+	RolePriority           types.Int64    `tfsdk:"role_priority"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
 }
 
 type AWSEKSAvailabilitiesResource struct {
-	client *configsvc.Client
+	client         *configsvc.Client
+	defaultTimeout time.Duration
+	deployment     providerdata.Deployment
 }
 
 var (
@@ -45,15 +50,18 @@ func (r *AWSEKSAvailabilitiesResource) Configure(_ context.Context, req resource
 		return
 	}
 
-	cfg, ok := req.ProviderData.(*config_client.Context)
+	providerData, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
+	cfg := providerData.Config
+	r.defaultTimeout = providerData.DefaultTimeout
+	r.deployment = providerData.Deployment
 	client := configsvc.NewFromConfig(cfg)
 
 	r.client = client
@@ -93,6 +101,14 @@ func (r *AWSEKSAvailabilitiesResource) Schema(ctx context.Context, req resource.
 			},
 		},
 		MarkdownDescription: `A specifier to make AWS EKS resources available for selection under a particular Access Workflow`,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -120,6 +136,15 @@ func (r *AWSEKSAvailabilitiesResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	input := &configv1alpha1.CreateAvailabilitySpecRequest{
 		Role: &entityv1alpha1.EID{
 			Type: "AWS::EKS::ServiceAccount",
@@ -159,6 +184,7 @@ func (r *AWSEKSAvailabilitiesResource) Create(ctx context.Context, req resource.
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -175,6 +201,23 @@ func (r *AWSEKSAvailabilitiesResource) Read(ctx context.Context, req resource.Re
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	//read the state from the client
 	res, err := r.client.AvailabilitySpec().GetAvailabilitySpec(ctx, connect.NewRequest(&configv1alpha1.GetAvailabilitySpecRequest{
@@ -194,6 +237,7 @@ func (r *AWSEKSAvailabilitiesResource) Read(ctx context.Context, req resource.Re
 
 	state.ID = types.StringValue(res.Msg.AvailabilitySpec.Id)
 	state.WorkflowID = types.StringValue(res.Msg.AvailabilitySpec.WorkflowId)
+	state.RolePriority = types.Int64PointerValue(res.Msg.AvailabilitySpec.RolePriority)
 	state.AWSEKSSelectorID = types.StringValue(res.Msg.AvailabilitySpec.Target.Id)
 	state.AWSEKSServiceAccountID = types.StringValue(res.Msg.AvailabilitySpec.Role.Id)
 
@@ -202,6 +246,7 @@ func (r *AWSEKSAvailabilitiesResource) Read(ctx context.Context, req resource.Re
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *AWSEKSAvailabilitiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -224,6 +269,20 @@ func (r *AWSEKSAvailabilitiesResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	input := &configv1alpha1.AvailabilitySpec{
 		Id: data.ID.ValueString(),
 		Role: &entityv1alpha1.EID{
@@ -274,6 +333,7 @@ func (r *AWSEKSAvailabilitiesResource) Update(ctx context.Context, req resource.
 	data.ID = types.StringValue(res.Msg.AvailabilitySpec.Id)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *AWSEKSAvailabilitiesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -296,6 +356,20 @@ func (r *AWSEKSAvailabilitiesResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.AvailabilitySpec().DeleteAvailabilitySpec(ctx, connect.NewRequest(&configv1alpha1.DeleteAvailabilitySpecRequest{
 		Id: data.ID.ValueString(),
 	}))
@@ -0,0 +1,43 @@
+package aws_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccAWSEKSAvailabilities(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_eks_availabilities.test",
+			Config: `
+resource "commonfate_eks_availabilities" "test" {
+  workflow_id                = "aw_123"
+  aws_eks_selector_id        = "sel_123"
+  aws_eks_service_account_id = "eksa_123"
+  aws_identity_store_id      = "d-123"
+}
+`,
+			Check: map[string]string{
+				"workflow_id":                "aw_123",
+				"aws_eks_selector_id":        "sel_123",
+				"aws_eks_service_account_id": "eksa_123",
+				"aws_identity_store_id":      "d-123",
+			},
+			UpdatedConfig: `
+resource "commonfate_eks_availabilities" "test" {
+  workflow_id                = "aw_456"
+  aws_eks_selector_id        = "sel_123"
+  aws_eks_service_account_id = "eksa_123"
+  aws_identity_store_id      = "d-123"
+  role_priority              = 10
+}
+`,
+			UpdatedCheck: map[string]string{
+				"workflow_id":   "aw_456",
+				"role_priority": "10",
+			},
+		},
+	})
+}
@@ -3,12 +3,14 @@ package aws
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
-	config_client "github.com/common-fate/sdk/config"
 	configv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1"
 	entityv1alpha1 "github.com/common-fate/sdk/gen/commonfate/entity/v1alpha1"
 	"github.com/common-fate/sdk/service/control/configsvc"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,16 +20,19 @@ import (
 )
 
 type AWSEKSAvailability struct {
-	ID                     types.String `tfsdk:"id"`
-	WorkflowID             types.String `tfsdk:"workflow_id"`
-	AWSEKSServiceAccountID types.String `tfsdk:"aws_eks_service_account_id"`
-	AWSEKSClusterID        types.String `tfsdk:"aws_eks_cluster_id"`
-	AWSIdentityStoreID     types.String `tfsdk:"aws_identity_store_id"`
-	RolePriority           types.Int64  `tfsdk:"role_priority"`
+	ID                     types.String   `tfsdk:"id"`
+	WorkflowID             types.String   `tfsdk:"workflow_id"`
+	AWSEKSServiceAccountID types.String   `tfsdk:"aws_eks_service_account_id"`
+	AWSEKSClusterID        types.String   `tfsdk:"aws_eks_cluster_id"`
+	AWSIdentityStoreID     types.String   `tfsdk:"aws_identity_store_id"`
+	RolePriority           types.Int64    `tfsdk:"role_priority"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
 }
 
 type AWSEKSAvailabilityResource struct {
-	client *configsvc.Client
+	client         *configsvc.Client
+	defaultTimeout time.Duration
+	deployment     providerdata.Deployment
 }
 
 var (
@@ -47,15 +52,18 @@ func (r *AWSEKSAvailabilityResource) Configure(_ context.Context, req resource.C
 		return
 	}
 
-	cfg, ok := req.ProviderData.(*config_client.Context)
+	providerData, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
+	cfg := providerData.Config
+	r.defaultTimeout = providerData.DefaultTimeout
+	r.deployment = providerData.Deployment
 	client := configsvc.NewFromConfig(cfg)
 
 	r.client = client
@@ -97,6 +105,14 @@ func (r *AWSEKSAvailabilityResource) Schema(ctx context.Context, req resource.Sc
 			},
 		},
 		MarkdownDescription: `A specifier to make a single AWS EKS resource available for selection under a particular Access Workflow`,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -124,6 +140,15 @@ func (r *AWSEKSAvailabilityResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	input := &configv1alpha1.CreateAvailabilitySpecRequest{
 		Role: &entityv1alpha1.EID{
 			Type: "AWS::EKS::ServiceAccount",
@@ -163,6 +188,7 @@ func (r *AWSEKSAvailabilityResource) Create(ctx context.Context, req resource.Cr
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -179,6 +205,23 @@ func (r *AWSEKSAvailabilityResource) Read(ctx context.Context, req resource.Read
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	//read the state from the client
 	res, err := r.client.AvailabilitySpec().GetAvailabilitySpec(ctx, connect.NewRequest(&configv1alpha1.GetAvailabilitySpecRequest{
@@ -198,14 +241,16 @@ func (r *AWSEKSAvailabilityResource) Read(ctx context.Context, req resource.Read
 
 	state.ID = types.StringValue(res.Msg.AvailabilitySpec.Id)
 	state.WorkflowID = types.StringValue(res.Msg.AvailabilitySpec.WorkflowId)
-	state.AWSEKSServiceAccountID = types.StringValue(res.Msg.AvailabilitySpec.Target.Id)
-	state.AWSEKSClusterID = types.StringValue(res.Msg.AvailabilitySpec.Role.Id)
+	state.RolePriority = types.Int64PointerValue(res.Msg.AvailabilitySpec.RolePriority)
+	state.AWSEKSServiceAccountID = types.StringValue(res.Msg.AvailabilitySpec.Role.Id)
+	state.AWSEKSClusterID = types.StringValue(res.Msg.AvailabilitySpec.Target.Id)
 
 	if res.Msg.AvailabilitySpec.IdentityDomain != nil {
 		state.AWSIdentityStoreID = types.StringValue(res.Msg.AvailabilitySpec.IdentityDomain.Id)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *AWSEKSAvailabilityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -228,6 +273,20 @@ func (r *AWSEKSAvailabilityResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	input := &configv1alpha1.AvailabilitySpec{
 		Id: data.ID.ValueString(),
 		Role: &entityv1alpha1.EID{
@@ -278,6 +337,7 @@ func (r *AWSEKSAvailabilityResource) Update(ctx context.Context, req resource.Up
 	data.ID = types.StringValue(res.Msg.AvailabilitySpec.Id)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *AWSEKSAvailabilityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -300,6 +360,20 @@ func (r *AWSEKSAvailabilityResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.AvailabilitySpec().DeleteAvailabilitySpec(ctx, connect.NewRequest(&configv1alpha1.DeleteAvailabilitySpecRequest{
 		Id: data.ID.ValueString(),
 	}))
@@ -0,0 +1,43 @@
+package aws_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccAWSEKSAvailability(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_aws_eks_availability.test",
+			Config: `
+resource "commonfate_aws_eks_availability" "test" {
+  workflow_id                = "aw_123"
+  aws_eks_cluster_id         = "eks_123"
+  aws_eks_service_account_id = "eksa_123"
+  aws_identity_store_id      = "d-123"
+}
+`,
+			Check: map[string]string{
+				"workflow_id":                "aw_123",
+				"aws_eks_cluster_id":         "eks_123",
+				"aws_eks_service_account_id": "eksa_123",
+				"aws_identity_store_id":      "d-123",
+			},
+			UpdatedConfig: `
+resource "commonfate_aws_eks_availability" "test" {
+  workflow_id                = "aw_456"
+  aws_eks_cluster_id         = "eks_123"
+  aws_eks_service_account_id = "eksa_123"
+  aws_identity_store_id      = "d-123"
+  role_priority              = 10
+}
+`,
+			UpdatedCheck: map[string]string{
+				"workflow_id":   "aw_456",
+				"role_priority": "10",
+			},
+		},
+	})
+}
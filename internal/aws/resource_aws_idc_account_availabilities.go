@@ -3,12 +3,14 @@ package aws
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
-	config_client "github.com/common-fate/sdk/config"
 	configv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1"
 	entityv1alpha1 "github.com/common-fate/sdk/gen/commonfate/entity/v1alpha1"
 	"github.com/common-fate/sdk/service/control/configsvc"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,16 +20,19 @@ import (
 )
 
 type AWSIDCAccountAvailabilities struct {
-	ID                 types.String `tfsdk:"id"`
-	WorkflowID         types.String `tfsdk:"workflow_id"`
-	PermissionSetARN   types.String `tfsdk:"aws_permission_set_arn"`
-	AccountSelectorID  types.String `tfsdk:"aws_account_selector_id"`
-	AWSIdentityStoreID types.String `tfsdk:"aws_identity_store_id"`
-	RolePriority       types.Int64  `tfsdk:"role_priority"`
+	ID                 types.String   `tfsdk:"id"`
+	WorkflowID         types.String   `tfsdk:"workflow_id"`
+	PermissionSetARN   types.String   `tfsdk:"aws_permission_set_arn"`
+	AccountSelectorID  types.String   `tfsdk:"aws_account_selector_id"`
+	AWSIdentityStoreID types.String   `tfsdk:"aws_identity_store_id"`
+	RolePriority       types.Int64    `tfsdk:"role_priority"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 type AWSIDCAccountAvailabilitiesResource struct {
-	client *configsvc.Client
+	client         *configsvc.Client
+	defaultTimeout time.Duration
+	deployment     providerdata.Deployment
 }
 
 var (
@@ -47,15 +52,18 @@ func (r *AWSIDCAccountAvailabilitiesResource) Configure(_ context.Context, req r
 		return
 	}
 
-	cfg, ok := req.ProviderData.(*config_client.Context)
+	providerData, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
+	cfg := providerData.Config
+	r.defaultTimeout = providerData.DefaultTimeout
+	r.deployment = providerData.Deployment
 	client := configsvc.NewFromConfig(cfg)
 
 	r.client = client
@@ -98,6 +106,14 @@ func (r *AWSIDCAccountAvailabilitiesResource) Schema(ctx context.Context, req re
 			},
 		},
 		MarkdownDescription: `A specifier to make AWS accounts available for selection under a particular Access Workflow`,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -125,6 +141,15 @@ func (r *AWSIDCAccountAvailabilitiesResource) Create(ctx context.Context, req re
 		return
 	}
 
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	input := &configv1alpha1.CreateAvailabilitySpecRequest{
 		Role: &entityv1alpha1.EID{
 			Type: "AWS::IDC::PermissionSet",
@@ -164,6 +189,7 @@ func (r *AWSIDCAccountAvailabilitiesResource) Create(ctx context.Context, req re
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -180,6 +206,23 @@ func (r *AWSIDCAccountAvailabilitiesResource) Read(ctx context.Context, req reso
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	//read the state from the client
 	res, err := r.client.AvailabilitySpec().GetAvailabilitySpec(ctx, connect.NewRequest(&configv1alpha1.GetAvailabilitySpecRequest{
@@ -199,6 +242,7 @@ func (r *AWSIDCAccountAvailabilitiesResource) Read(ctx context.Context, req reso
 
 	state.ID = types.StringValue(res.Msg.AvailabilitySpec.Id)
 	state.WorkflowID = types.StringValue(res.Msg.AvailabilitySpec.WorkflowId)
+	state.RolePriority = types.Int64PointerValue(res.Msg.AvailabilitySpec.RolePriority)
 	state.PermissionSetARN = types.StringValue(res.Msg.AvailabilitySpec.Role.Id)
 	state.AccountSelectorID = types.StringValue(res.Msg.AvailabilitySpec.Target.Id)
 
@@ -207,6 +251,7 @@ func (r *AWSIDCAccountAvailabilitiesResource) Read(ctx context.Context, req reso
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *AWSIDCAccountAvailabilitiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -229,6 +274,20 @@ func (r *AWSIDCAccountAvailabilitiesResource) Update(ctx context.Context, req re
 		return
 	}
 
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	input := &configv1alpha1.AvailabilitySpec{
 		Id: data.ID.ValueString(),
 		Role: &entityv1alpha1.EID{
@@ -281,6 +340,7 @@ func (r *AWSIDCAccountAvailabilitiesResource) Update(ctx context.Context, req re
 	data.ID = types.StringValue(res.Msg.AvailabilitySpec.Id)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *AWSIDCAccountAvailabilitiesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -303,6 +363,20 @@ func (r *AWSIDCAccountAvailabilitiesResource) Delete(ctx context.Context, req re
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.AvailabilitySpec().DeleteAvailabilitySpec(ctx, connect.NewRequest(&configv1alpha1.DeleteAvailabilitySpecRequest{
 		Id: data.ID.ValueString(),
 	}))
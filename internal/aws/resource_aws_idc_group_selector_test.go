@@ -0,0 +1,42 @@
+package aws_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccAWSIDCGroupSelector(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_aws_idc_group_selector.test",
+			Config: `
+resource "commonfate_aws_idc_group_selector" "test" {
+  id                  = "test"
+  name                = "Test"
+  aws_organization_id = "o-123"
+  when                = "resource.name like \"*admins*\""
+}
+`,
+			Check: map[string]string{
+				"id":                  "test",
+				"name":                "Test",
+				"aws_organization_id": "o-123",
+				"when":                "resource.name like \"*admins*\"",
+			},
+			UpdatedConfig: `
+resource "commonfate_aws_idc_group_selector" "test" {
+  id                  = "test"
+  name                = "Test Updated"
+  aws_organization_id = "o-123"
+  when                = "true"
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name": "Test Updated",
+				"when": "true",
+			},
+		},
+	})
+}
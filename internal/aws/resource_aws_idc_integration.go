@@ -3,12 +3,15 @@ package aws
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
-	config_client "github.com/common-fate/sdk/config"
+	"github.com/common-fate/grab"
 	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
 	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
 	"github.com/common-fate/sdk/service/control/integration"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,20 +21,23 @@ import (
 )
 
 type AWSIDCIntegrationModel struct {
-	Id                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	SSOInstanceARN     types.String `tfsdk:"sso_instance_arn"`
-	IdentityStoreID    types.String `tfsdk:"identity_store_id"`
-	SSORegion          types.String `tfsdk:"sso_region"`
-	ReaderRoleARN      types.String `tfsdk:"reader_role_arn"`
-	AuditRoleName      types.String `tfsdk:"audit_role_name"`
-	ResourceRegions    types.Set    `tfsdk:"resource_regions"`
-	SSOAccessPortalURL types.String `tfsdk:"sso_access_portal_url"`
-	ProvisionerRoleARN types.String `tfsdk:"provisioner_role_arn"`
+	Id                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	SSOInstanceARN     types.String   `tfsdk:"sso_instance_arn"`
+	IdentityStoreID    types.String   `tfsdk:"identity_store_id"`
+	SSORegion          types.String   `tfsdk:"sso_region"`
+	ReaderRoleARN      types.String   `tfsdk:"reader_role_arn"`
+	AuditRoleName      types.String   `tfsdk:"audit_role_name"`
+	ResourceRegions    types.Set      `tfsdk:"resource_regions"`
+	SSOAccessPortalURL types.String   `tfsdk:"sso_access_portal_url"`
+	ProvisionerRoleARN types.String   `tfsdk:"provisioner_role_arn"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 type AWSIDCIntegrationResource struct {
-	client integrationv1alpha1connect.IntegrationServiceClient
+	client         integrationv1alpha1connect.IntegrationServiceClient
+	defaultTimeout time.Duration
+	deployment     providerdata.Deployment
 }
 
 var (
@@ -51,15 +57,18 @@ func (r *AWSIDCIntegrationResource) Configure(_ context.Context, req resource.Co
 		return
 	}
 
-	cfg, ok := req.ProviderData.(*config_client.Context)
+	providerData, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
+	cfg := providerData.Config
+	r.defaultTimeout = providerData.DefaultTimeout
+	r.deployment = providerData.Deployment
 	client := integration.NewFromConfig(cfg)
 
 	r.client = client
@@ -118,6 +127,14 @@ func (r *AWSIDCIntegrationResource) Schema(ctx context.Context, req resource.Sch
 			},
 		},
 		MarkdownDescription: `Registers an AWS IAM Identity Center integration`,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -145,6 +162,15 @@ func (r *AWSIDCIntegrationResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var resourceRegions []string
 	diag := data.ResourceRegions.ElementsAs(ctx, &resourceRegions, false)
 	if diag.HasError() {
@@ -185,6 +211,7 @@ func (r *AWSIDCIntegrationResource) Create(ctx context.Context, req resource.Cre
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -201,9 +228,26 @@ func (r *AWSIDCIntegrationResource) Read(ctx context.Context, req resource.ReadR
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	//read the state from the client
-	_, err := r.client.GetIntegration(ctx, connect.NewRequest(&integrationv1alpha1.GetIntegrationRequest{
+	res, err := r.client.GetIntegration(ctx, connect.NewRequest(&integrationv1alpha1.GetIntegrationRequest{
 		Id: state.Id.ValueString(),
 	}))
 
@@ -218,7 +262,36 @@ func (r *AWSIDCIntegrationResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	integ := res.Msg.Integration.Config.GetAwsIdc()
+	if integ == nil {
+		resp.Diagnostics.AddError(
+			"Returned integration did not contain any AWS IAM Identity Center configuration",
+			"",
+		)
+		return
+	}
+
+	state.Name = types.StringValue(res.Msg.Integration.Name)
+	state.SSOInstanceARN = types.StringValue(integ.SsoInstanceArn)
+	state.IdentityStoreID = types.StringValue(integ.IdentityStoreId)
+	state.SSORegion = types.StringValue(integ.SsoRegion)
+	state.ReaderRoleARN = types.StringValue(integ.ReaderRoleArn)
+	state.ProvisionerRoleARN = types.StringPointerValue(grab.If(integ.ProvisionerRoleArn == "", nil, &integ.ProvisionerRoleArn))
+	state.AuditRoleName = types.StringPointerValue(grab.If(integ.AuditRoleName == "", nil, &integ.AuditRoleName))
+	state.SSOAccessPortalURL = types.StringPointerValue(grab.If(integ.SsoAccessPortalUrl == "", nil, &integ.SsoAccessPortalUrl))
+
+	state.ResourceRegions = types.SetNull(types.StringType)
+	if len(integ.ResourceRegions) > 0 {
+		regions, diags := types.SetValueFrom(ctx, types.StringType, integ.ResourceRegions)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.ResourceRegions = regions
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *AWSIDCIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -240,6 +313,20 @@ func (r *AWSIDCIntegrationResource) Update(ctx context.Context, req resource.Upd
 
 		return
 	}
+
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	var resourceRegions []string
 	diag := data.ResourceRegions.ElementsAs(ctx, &resourceRegions, false)
 	if diag.HasError() {
@@ -283,6 +370,7 @@ func (r *AWSIDCIntegrationResource) Update(ctx context.Context, req resource.Upd
 	data.Id = types.StringValue(res.Msg.Integration.Id)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *AWSIDCIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -305,6 +393,20 @@ func (r *AWSIDCIntegrationResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.DeleteIntegration(ctx, connect.NewRequest(&integrationv1alpha1.DeleteIntegrationRequest{
 		Id: data.Id.ValueString(),
 	}))
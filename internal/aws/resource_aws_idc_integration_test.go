@@ -0,0 +1,52 @@
+package aws_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccAWSIDCIntegration(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_aws_idc_integration.test",
+			Config: `
+resource "commonfate_aws_idc_integration" "test" {
+  name              = "test"
+  reader_role_arn   = "arn:aws:iam::123456789012:role/reader"
+  sso_instance_arn  = "arn:aws:sso:::instance/ssoins-123"
+  identity_store_id = "d-123"
+  sso_region        = "us-east-1"
+}
+`,
+			Check: map[string]string{
+				"name":              "test",
+				"reader_role_arn":   "arn:aws:iam::123456789012:role/reader",
+				"sso_instance_arn":  "arn:aws:sso:::instance/ssoins-123",
+				"identity_store_id": "d-123",
+				"sso_region":        "us-east-1",
+			},
+			UpdatedConfig: `
+resource "commonfate_aws_idc_integration" "test" {
+  name                  = "test-updated"
+  reader_role_arn       = "arn:aws:iam::123456789012:role/reader"
+  provisioner_role_arn  = "arn:aws:iam::123456789012:role/provisioner"
+  audit_role_name       = "audit"
+  sso_instance_arn      = "arn:aws:sso:::instance/ssoins-123"
+  identity_store_id     = "d-123"
+  sso_region            = "us-east-1"
+  sso_access_portal_url = "https://example.awsapps.com/start"
+  resource_regions      = ["us-east-1", "us-west-2"]
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name":                  "test-updated",
+				"provisioner_role_arn":  "arn:aws:iam::123456789012:role/provisioner",
+				"audit_role_name":       "audit",
+				"sso_access_portal_url": "https://example.awsapps.com/start",
+				"resource_regions.#":    "2",
+			},
+		},
+	})
+}
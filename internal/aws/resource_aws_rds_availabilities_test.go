@@ -0,0 +1,43 @@
+package aws_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccAWSRDSDatabaseAvailabilities(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_aws_rds_database_availabilities.test",
+			Config: `
+resource "commonfate_aws_rds_database_availabilities" "test" {
+  workflow_id                  = "aw_123"
+  aws_rds_database_selector_id = "sel_123"
+  aws_rds_database_user_id     = "read-only"
+  aws_identity_store_id        = "d-123"
+}
+`,
+			Check: map[string]string{
+				"workflow_id":                  "aw_123",
+				"aws_rds_database_selector_id": "sel_123",
+				"aws_rds_database_user_id":     "read-only",
+				"aws_identity_store_id":        "d-123",
+			},
+			UpdatedConfig: `
+resource "commonfate_aws_rds_database_availabilities" "test" {
+  workflow_id                  = "aw_456"
+  aws_rds_database_selector_id = "sel_123"
+  aws_rds_database_user_id     = "read-only"
+  aws_identity_store_id        = "d-123"
+  role_priority                = 10
+}
+`,
+			UpdatedCheck: map[string]string{
+				"workflow_id":   "aw_456",
+				"role_priority": "10",
+			},
+		},
+	})
+}
@@ -3,12 +3,15 @@ package aws
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
-	config_client "github.com/common-fate/sdk/config"
+	"github.com/common-fate/grab"
 	configv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1"
 	"github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1/configv1alpha1connect"
 	"github.com/common-fate/sdk/service/control/configsvc"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,16 +21,19 @@ import (
 )
 
 type AWSResourceScannerModel struct {
-	ID                  types.String `tfsdk:"id"`
-	IntegrationID       types.String `tfsdk:"integration_id"`
-	Regions             types.Set    `tfsdk:"regions"`
-	ResourceTypes       types.Set    `tfsdk:"resource_types"`
-	FilterForAccountIDs types.Set    `tfsdk:"filter_for_account_ids"`
-	RoleName            types.String `tfsdk:"role_name"`
+	ID                  types.String   `tfsdk:"id"`
+	IntegrationID       types.String   `tfsdk:"integration_id"`
+	Regions             types.Set      `tfsdk:"regions"`
+	ResourceTypes       types.Set      `tfsdk:"resource_types"`
+	FilterForAccountIDs types.Set      `tfsdk:"filter_for_account_ids"`
+	RoleName            types.String   `tfsdk:"role_name"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 type AWSResourceScannerResource struct {
-	client configv1alpha1connect.AWSResourceScannerServiceClient
+	client         configv1alpha1connect.AWSResourceScannerServiceClient
+	defaultTimeout time.Duration
+	deployment     providerdata.Deployment
 }
 
 func NewAWSResourceScannerResource() resource.Resource {
@@ -51,15 +57,18 @@ func (r *AWSResourceScannerResource) Configure(_ context.Context, req resource.C
 		return
 	}
 
-	cfg, ok := req.ProviderData.(*config_client.Context)
+	providerData, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
+	cfg := providerData.Config
+	r.defaultTimeout = providerData.DefaultTimeout
+	r.deployment = providerData.Deployment
 	client := configsvc.NewFromConfig(cfg).AWSResourceScanner()
 
 	r.client = client
@@ -104,6 +113,14 @@ func (r *AWSResourceScannerResource) Schema(ctx context.Context, req resource.Sc
 			},
 		},
 		MarkdownDescription: `Registers an AWS Resource Scanner`,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -131,6 +148,15 @@ func (r *AWSResourceScannerResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var resourceRegions []string
 	diag := data.Regions.ElementsAs(ctx, &resourceRegions, false)
 	if diag.HasError() {
@@ -175,6 +201,7 @@ func (r *AWSResourceScannerResource) Create(ctx context.Context, req resource.Cr
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -191,9 +218,26 @@ func (r *AWSResourceScannerResource) Read(ctx context.Context, req resource.Read
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	//read the state from the client
-	_, err := r.client.GetAWSResourceScanner(ctx, connect.NewRequest(&configv1alpha1.GetAWSResourceScannerRequest{
+	res, err := r.client.GetAWSResourceScanner(ctx, connect.NewRequest(&configv1alpha1.GetAWSResourceScannerRequest{
 		Id: state.ID.ValueString(),
 	}))
 
@@ -208,7 +252,34 @@ func (r *AWSResourceScannerResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	scanner := res.Msg.ResourceScanner
+	state.IntegrationID = types.StringValue(scanner.IntegrationId)
+	state.RoleName = types.StringPointerValue(grab.If(scanner.RoleName == "", nil, &scanner.RoleName))
+
+	regions, diags := types.SetValueFrom(ctx, types.StringType, scanner.Regions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Regions = regions
+
+	state.ResourceTypes = types.SetNull(types.StringType)
+	if len(scanner.ResourceTypes) > 0 {
+		state.ResourceTypes, diags = types.SetValueFrom(ctx, types.StringType, scanner.ResourceTypes)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	state.FilterForAccountIDs = types.SetNull(types.StringType)
+	if len(scanner.FilterForAccountIds) > 0 {
+		state.FilterForAccountIDs, diags = types.SetValueFrom(ctx, types.StringType, scanner.FilterForAccountIds)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *AWSResourceScannerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -231,6 +302,20 @@ func (r *AWSResourceScannerResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var resourceRegions []string
 	diag := data.Regions.ElementsAs(ctx, &resourceRegions, false)
 	if diag.HasError() {
@@ -283,6 +368,7 @@ func (r *AWSResourceScannerResource) Update(ctx context.Context, req resource.Up
 	data.ID = types.StringValue(res.Msg.ResourceScanner.Id)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *AWSResourceScannerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -305,6 +391,20 @@ func (r *AWSResourceScannerResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.DeleteAWSResourceScanner(ctx, connect.NewRequest(&configv1alpha1.DeleteAWSResourceScannerRequest{
 		Id: data.ID.ValueString(),
 	}))
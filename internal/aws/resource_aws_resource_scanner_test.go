@@ -0,0 +1,41 @@
+package aws_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccAWSResourceScanner(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_aws_resource_scanner.test",
+			Config: `
+resource "commonfate_aws_resource_scanner" "test" {
+  integration_id = "int_123"
+  regions        = ["us-east-1"]
+}
+`,
+			Check: map[string]string{
+				"integration_id": "int_123",
+				"regions.#":      "1",
+			},
+			UpdatedConfig: `
+resource "commonfate_aws_resource_scanner" "test" {
+  integration_id         = "int_123"
+  regions                = ["us-east-1", "us-west-2"]
+  role_name              = "scanner"
+  resource_types         = ["AWS::RDS::DBInstance"]
+  filter_for_account_ids = ["123456789012"]
+}
+`,
+			UpdatedCheck: map[string]string{
+				"regions.#":                "2",
+				"role_name":                "scanner",
+				"resource_types.#":         "1",
+				"filter_for_account_ids.#": "1",
+			},
+		},
+	})
+}
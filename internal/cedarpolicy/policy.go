@@ -0,0 +1,330 @@
+package cedarpolicy
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/cedar-policy/cedar-go"
+	publicast "github.com/cedar-policy/cedar-go/ast"
+	"github.com/cedar-policy/cedar-go/types"
+	"github.com/cedar-policy/cedar-go/x/exp/ast"
+)
+
+const (
+	EffectPermit = "permit"
+	EffectForbid = "forbid"
+)
+
+// Scope is the principal or resource scope of a policy. If nothing is set the scope matches anything.
+type Scope struct {
+	// Eq matches exactly this entity. It can't be combined with In or Is.
+	Eq *types.EntityUID
+	// In matches this entity and its descendants.
+	In *types.EntityUID
+	// Is matches entities of this type.
+	Is types.EntityType
+}
+
+// ActionScope is the action scope of a policy. If nothing is set the scope matches any action.
+type ActionScope struct {
+	// Eq matches exactly this action. It can't be combined with In.
+	Eq *types.EntityUID
+	// In matches any of these actions or their descendants.
+	In []types.EntityUID
+}
+
+// Policy is a single Cedar policy in a structured form.
+type Policy struct {
+	Effect      string
+	Annotations map[string]string
+	Principal   Scope
+	Action      ActionScope
+	Resource    Scope
+	// When and Unless are Cedar expressions, which are empty if the policy doesn't have the condition.
+	When   string
+	Unless string
+}
+
+// ParseError is a syntax error in Cedar text.
+type ParseError struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+var annotationKey = regexp.MustCompile(`^[_a-zA-Z][_a-zA-Z0-9]*$`)
+
+// Render renders the policies as Cedar text, in the same format as Normalize.
+func Render(policies []Policy) (string, error) {
+	var out []string
+	for i, p := range policies {
+		policy, err := p.toAST()
+		if err != nil {
+			return "", fmt.Errorf("policy %d: %w", i, err)
+		}
+		out = append(out, string(cedar.NewPolicyFromAST((*publicast.Policy)(policy)).MarshalCedar()))
+	}
+	return strings.Join(out, "\n\n"), nil
+}
+
+// Normalize parses Cedar text and renders it again, dropping comments and formatting it consistently,
+// so that texts which differ only in their formatting can be compared. The policies are sorted, as the
+// order of the policies in a policy set makes no difference to it. The operands of && are left in their
+// order, as && short-circuits and reordering it can turn a false condition into an evaluation error.
+func Normalize(text string) (string, error) {
+	policies, err := Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	out := make([]string, len(policies))
+	for i, p := range policies {
+		out[i] = string(p.MarshalCedar())
+	}
+	sort.Strings(out)
+	return strings.Join(out, "\n\n"), nil
+}
+
+// Equal reports whether two Cedar texts contain the same policies, ignoring formatting, comments
+// and the order of the policies.
+// Texts which can't be parsed are only equal if they are identical.
+func Equal(a, b string) bool {
+	if a == b {
+		return true
+	}
+	na, err := Normalize(a)
+	if err != nil {
+		return false
+	}
+	nb, err := Normalize(b)
+	if err != nil {
+		return false
+	}
+	return na == nb
+}
+
+// EqualExpressions reports whether two Cedar expressions are the same, ignoring formatting.
+// Expressions which can't be parsed are only equal if they are identical.
+func EqualExpressions(a, b string) bool {
+	if a == b {
+		return true
+	}
+	na, err := ParseExpression(a)
+	if err != nil {
+		return false
+	}
+	nb, err := ParseExpression(b)
+	if err != nil {
+		return false
+	}
+	return renderExpression(na) == renderExpression(nb)
+}
+
+// FromText parses Cedar text into structured policies.
+//
+// A policy with several when or unless clauses is returned with the clauses combined into one expression,
+// which Render turns back into an equivalent policy.
+func FromText(text string) ([]Policy, error) {
+	policies, err := Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]Policy, len(policies))
+	for i, p := range policies {
+		out[i] = fromAST((*ast.Policy)(p.AST()))
+	}
+	return out, nil
+}
+
+// ParseExpression parses a Cedar expression, such as the body of a when clause.
+// The line and column of a syntax error are relative to the expression.
+func ParseExpression(expr string) (ast.IsNode, error) {
+	// the expression is wrapped in a policy on lines of its own, so that
+	// the position of an error only needs its line adjusting.
+	policies, err := Parse("permit ( principal, action, resource ) when {\n" + expr + "\n};")
+	if perr, ok := err.(*ParseError); ok {
+		perr.Line--
+		// errors found in the closing line of the wrapper mean that the expression is incomplete.
+		if lines := strings.Split(expr, "\n"); perr.Line > len(lines) {
+			perr.Line = len(lines)
+			perr.Column = len(lines[len(lines)-1]) + 1
+			perr.Message = "unexpected end of expression"
+		}
+		return nil, perr
+	} else if err != nil {
+		return nil, err
+	}
+
+	if len(policies) != 1 || len(policies[0].AST().Conditions) != 1 {
+		return nil, fmt.Errorf("%q is not a single Cedar expression", expr)
+	}
+	return policies[0].AST().Conditions[0].Body, nil
+}
+
+func (p Policy) toAST() (*ast.Policy, error) {
+	var policy *ast.Policy
+	switch p.Effect {
+	case EffectPermit:
+		policy = ast.Permit()
+	case EffectForbid:
+		policy = ast.Forbid()
+	default:
+		return nil, fmt.Errorf("effect must be %q or %q, got %q", EffectPermit, EffectForbid, p.Effect)
+	}
+
+	keys := make([]string, 0, len(p.Annotations))
+	for k := range p.Annotations {
+		if !annotationKey.MatchString(k) {
+			return nil, fmt.Errorf("annotation %q is not a valid Cedar identifier", k)
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		policy.Annotations = append(policy.Annotations, ast.AnnotationType{Key: types.Ident(k), Value: types.String(p.Annotations[k])})
+	}
+
+	principal, err := p.Principal.toAST()
+	if err != nil {
+		return nil, fmt.Errorf("principal: %w", err)
+	}
+	// every scope a Scope renders to can be used for both the principal and the resource.
+	policy.Principal = principal.(ast.IsPrincipalScopeNode)
+
+	resource, err := p.Resource.toAST()
+	if err != nil {
+		return nil, fmt.Errorf("resource: %w", err)
+	}
+	policy.Resource = resource.(ast.IsResourceScopeNode)
+
+	switch {
+	case p.Action.Eq != nil && p.Action.In != nil:
+		return nil, fmt.Errorf("action: eq and in can't both be set")
+	case p.Action.Eq != nil:
+		policy.Action = ast.Scope{}.Eq(*p.Action.Eq)
+	case p.Action.In != nil:
+		policy.Action = ast.Scope{}.InSet(p.Action.In)
+	}
+
+	if p.When != "" {
+		body, err := ParseExpression(p.When)
+		if err != nil {
+			return nil, fmt.Errorf("when: %w", err)
+		}
+		policy.Conditions = append(policy.Conditions, ast.ConditionType{Condition: ast.ConditionWhen, Body: body})
+	}
+	if p.Unless != "" {
+		body, err := ParseExpression(p.Unless)
+		if err != nil {
+			return nil, fmt.Errorf("unless: %w", err)
+		}
+		policy.Conditions = append(policy.Conditions, ast.ConditionType{Condition: ast.ConditionUnless, Body: body})
+	}
+
+	return policy, nil
+}
+
+func (s Scope) toAST() (ast.IsScopeNode, error) {
+	switch {
+	case s.Eq != nil && (s.In != nil || s.Is != ""):
+		return nil, fmt.Errorf("eq can't be combined with in or is")
+	case s.Eq != nil:
+		return ast.Scope{}.Eq(*s.Eq), nil
+	case s.Is != "" && s.In != nil:
+		return ast.Scope{}.IsIn(s.Is, *s.In), nil
+	case s.Is != "":
+		return ast.Scope{}.Is(s.Is), nil
+	case s.In != nil:
+		return ast.Scope{}.In(*s.In), nil
+	}
+	return ast.Scope{}.All(), nil
+}
+
+func fromAST(policy *ast.Policy) Policy {
+	p := Policy{
+		Effect:    EffectForbid,
+		Principal: scopeFromAST(policy.Principal),
+		Resource:  scopeFromAST(policy.Resource),
+	}
+	if policy.Effect == ast.EffectPermit {
+		p.Effect = EffectPermit
+	}
+
+	if len(policy.Annotations) > 0 {
+		p.Annotations = map[string]string{}
+		for _, a := range policy.Annotations {
+			p.Annotations[string(a.Key)] = string(a.Value)
+		}
+	}
+
+	switch s := policy.Action.(type) {
+	case ast.ScopeTypeEq:
+		p.Action.Eq = &s.Entity
+	case ast.ScopeTypeIn:
+		p.Action.In = []types.EntityUID{s.Entity}
+	case ast.ScopeTypeInSet:
+		p.Action.In = s.Entities
+	}
+
+	// several clauses of the same kind are combined, as when { a } when { b } is equivalent to
+	// when { a && b }, and unless { a } unless { b } is equivalent to unless { a || b }.
+	var when, unless *ast.Node
+	for _, c := range policy.Conditions {
+		body := ast.NewNode(c.Body)
+		switch {
+		case c.Condition == ast.ConditionWhen && when == nil:
+			when = &body
+		case c.Condition == ast.ConditionWhen:
+			combined := when.And(body)
+			when = &combined
+		case unless == nil:
+			unless = &body
+		default:
+			combined := unless.Or(body)
+			unless = &combined
+		}
+	}
+	if when != nil {
+		p.When = renderExpression(when.AsIsNode())
+	}
+	if unless != nil {
+		p.Unless = renderExpression(unless.AsIsNode())
+	}
+
+	return p
+}
+
+func scopeFromAST(scope ast.IsScopeNode) Scope {
+	switch s := scope.(type) {
+	case ast.ScopeTypeEq:
+		return Scope{Eq: &s.Entity}
+	case ast.ScopeTypeIn:
+		return Scope{In: &s.Entity}
+	case ast.ScopeTypeIs:
+		return Scope{Is: s.Type}
+	case ast.ScopeTypeIsIn:
+		return Scope{Is: s.Type, In: &s.Entity}
+	}
+	return Scope{}
+}
+
+// expressionPrefix and expressionSuffix surround the expression when a policy with only a when clause is rendered.
+const (
+	expressionPrefix = "permit ( principal, action, resource )\nwhen { "
+	expressionSuffix = " };"
+)
+
+// renderExpression renders a Cedar expression, which cedar-go can only do as part of a policy.
+func renderExpression(node ast.IsNode) string {
+	policy := ast.Permit()
+	policy.Conditions = []ast.ConditionType{{Condition: ast.ConditionWhen, Body: node}}
+	text := string(cedar.NewPolicyFromAST((*publicast.Policy)(policy)).MarshalCedar())
+	return strings.TrimSuffix(strings.TrimPrefix(text, expressionPrefix), expressionSuffix)
+}
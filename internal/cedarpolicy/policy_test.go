@@ -0,0 +1,164 @@
+package cedarpolicy
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cedar-policy/cedar-go/types"
+)
+
+func uid(t types.EntityType, id types.String) *types.EntityUID {
+	u := types.NewEntityUID(t, id)
+	return &u
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name     string
+		policies []Policy
+		want     string
+		wantErr  string
+	}{
+		{
+			name:     "empty scope",
+			policies: []Policy{{Effect: EffectPermit}},
+			want:     "permit ( principal, action, resource );",
+		},
+		{
+			name: "scopes and conditions",
+			policies: []Policy{{
+				Effect:      EffectForbid,
+				Annotations: map[string]string{"reason": "no prod", "id": "p1"},
+				Principal:   Scope{Is: "CF::User", In: uid("CF::Group", "contractors")},
+				Action:      ActionScope{Eq: uid("Access::Action", "Request")},
+				Resource:    Scope{In: uid("AWS::OrgUnit", "ou-prod")},
+				When:        "resource.name like \"prod-*\"",
+				Unless:      "context.request.duration < duration(\"1h\")",
+			}},
+			want: `@id("p1")
+@reason("no prod")
+forbid (
+    principal is CF::User in CF::Group::"contractors",
+    action == Access::Action::"Request",
+    resource in AWS::OrgUnit::"ou-prod"
+)
+when { resource.name like "prod-*" }
+unless { context.request.duration < duration("1h") };`,
+		},
+		{
+			name:     "several policies",
+			policies: []Policy{{Effect: EffectPermit}, {Effect: EffectForbid, Action: ActionScope{In: []types.EntityUID{*uid("Access::Action", "Close")}}}},
+			want:     "permit ( principal, action, resource );\n\nforbid (\n    principal,\n    action in [Access::Action::\"Close\"],\n    resource\n);",
+		},
+		{
+			name:     "eq and in",
+			policies: []Policy{{Effect: EffectPermit, Principal: Scope{Eq: uid("CF::User", "a"), In: uid("CF::Group", "b")}}},
+			wantErr:  "policy 0: principal: eq can't be combined with in or is",
+		},
+		{
+			name:     "invalid expression",
+			policies: []Policy{{Effect: EffectPermit, When: "true &&"}},
+			wantErr:  "policy 0: when: line 1, column 8: unexpected end of expression",
+		},
+		{
+			name:     "expression containing another policy",
+			policies: []Policy{{Effect: EffectPermit, When: "true }; permit(principal, action, resource) when { true"}},
+			wantErr:  `policy 0: when: "true }; permit(principal, action, resource) when { true" is not a single Cedar expression`,
+		},
+		{
+			name:     "invalid annotation",
+			policies: []Policy{{Effect: EffectPermit, Annotations: map[string]string{"not valid": ""}}},
+			wantErr:  `policy 0: annotation "not valid" is not a valid Cedar identifier`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Render(tt.policies)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("Render() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Render() = \n%s\nwant\n%s", got, tt.want)
+			}
+
+			// rendered policies round-trip through FromText.
+			parsed, err := FromText(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(parsed, tt.policies) {
+				t.Errorf("FromText() = %+v, want %+v", parsed, tt.policies)
+			}
+		})
+	}
+}
+
+func TestFromText_CombinesConditions(t *testing.T) {
+	got, err := FromText(`permit(principal, action in Access::Action::"Request", resource)
+when { resource.a } when { resource.b || resource.c }
+unless { resource.d } unless { resource.e };`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Policy{{
+		Effect: EffectPermit,
+		Action: ActionScope{In: []types.EntityUID{*uid("Access::Action", "Request")}},
+		When:   "resource.a && (resource.b || resource.c)",
+		Unless: "resource.d || resource.e",
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FromText() = %+v, want %+v", got, want)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"permit(principal, action, resource);", "permit (principal,action,resource) ;", true},
+		{"permit(principal, action, resource);", "// comment\npermit(\n  principal,\n  action,\n  resource\n);", true},
+		{"permit(principal, action, resource) when { resource.a == 1 };", "permit(principal, action, resource) when { (resource.a) == 1 };", true},
+		{"permit(principal, action, resource);", "forbid(principal, action, resource);", false},
+		{"permit(principal, action, resource) when { resource.a == 1 };", "permit(principal, action, resource) when { resource.a == 2 };", false},
+		{"permit(principal, action, resource);\nforbid(principal, action, resource);", "forbid(principal, action, resource);\npermit(principal, action, resource);", true},
+		// && short-circuits, so the reversed form raises an error when resource has no tag.
+		{"forbid(principal, action, resource) when { resource has tag && resource.tag == \"x\" };", "forbid(principal, action, resource) when { resource.tag == \"x\" && resource has tag };", false},
+		{"permit(principal, action, resource);\npermit(principal, action, resource);", "permit(principal, action, resource);", false},
+		{"not cedar", "not cedar", true},
+		{"not cedar", "not  cedar", false},
+	}
+	for _, tt := range tests {
+		if got := Equal(tt.a, tt.b); got != tt.want {
+			t.Errorf("Equal(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestEqualExpressions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{`resource.name == "prod"`, `resource.name=="prod"`, true},
+		{`principal in Group::"a" && resource.x`, "(principal in Group::\"a\")\n  && resource.x", true},
+		{`resource has tag && resource.tag == "x"`, `resource.tag == "x" && resource has tag`, false},
+		{`resource.a && resource.b && resource.c`, `resource.c && (resource.a && resource.b)`, false},
+		{`resource.a || resource.b`, `resource.b || resource.a`, false},
+		{`resource.name == "prod"`, `resource.name == "dev"`, false},
+		{`resource.tag_keys contains "prod"`, `resource.tag_keys contains "prod"`, true},
+		{`resource.tag_keys contains "prod"`, `resource.tag_keys  contains "prod"`, false},
+	}
+	for _, tt := range tests {
+		if got := EqualExpressions(tt.a, tt.b); got != tt.want {
+			t.Errorf("EqualExpressions(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package cedarpolicy
+
+import "github.com/cedar-policy/cedar-go/types"
+
+// actionType is the entity type of the actions which Common Fate authorizes.
+const actionType types.EntityType = "Access::Action"
+
+// actions are the IDs of the Access::Action entities in the Common Fate schema.
+var actions = map[types.String]bool{
+	"Activate":           true,
+	"Approve":            true,
+	"BreakglassActivate": true,
+	"Close":              true,
+	"Extend":             true,
+	"Request":            true,
+	"Revoke":             true,
+}
+
+// coreNamespaces are the namespaces which are defined by Common Fate itself, rather than by an integration.
+// Entity types in these namespaces which aren't in the schema are always mistakes.
+var coreNamespaces = []string{"Access", "CF"}
+
+// entityTypes are the entity types in the Common Fate schema, including the types
+// registered by the integrations which this provider can configure.
+//
+// Integrations register their entity types when they are deployed, so this list can lag behind the
+// schema of a deployment. Types outside of coreNamespaces which aren't listed here are only warned about.
+var entityTypes = map[types.EntityType]bool{
+	// Common Fate
+	"Access::Action":         true,
+	"Access::Availability":   true,
+	"Access::Grant":          true,
+	"Access::LinkedIdentity": true,
+	"Access::Request":        true,
+	"Access::Selector":       true,
+	"Access::Workflow":       true,
+	"CF::Group":              true,
+	"CF::User":               true,
+
+	// AWS
+	"AWS::Account":             true,
+	"AWS::EKS::Cluster":        true,
+	"AWS::EKS::ServiceAccount": true,
+	"AWS::IDC::Group":          true,
+	"AWS::IDC::GroupRole":      true,
+	"AWS::IDC::IdentityStore":  true,
+	"AWS::IDC::Instance":       true,
+	"AWS::IDC::PermissionSet":  true,
+	"AWS::IDC::User":           true,
+	"AWS::OrgUnit":             true,
+	"AWS::Organization":        true,
+	"AWS::RDS::DBInstance":     true,
+	"AWS::RDS::Database":       true,
+	"AWS::RDS::DatabaseUser":   true,
+	"AWS::S3::Bucket":          true,
+	"AWS::Selector":            true,
+
+	// GCP
+	"GCP::BigQuery::Dataset": true,
+	"GCP::BigQuery::Table":   true,
+	"GCP::Folder":            true,
+	"GCP::Organization":      true,
+	"GCP::Project":           true,
+	"GCP::Role":              true,
+	"GCP::RoleGroup":         true,
+
+	// Google Workspace
+	"GoogleWorkspace::Group":      true,
+	"GoogleWorkspace::User":       true,
+	"Google::Workspace::Customer": true,
+
+	// Okta
+	"Okta::Group":        true,
+	"Okta::GroupRole":    true,
+	"Okta::Organization": true,
+	"Okta::User":         true,
+
+	// Entra
+	"Entra::Group":     true,
+	"Entra::GroupRole": true,
+	"Entra::Tenant":    true,
+	"Entra::User":      true,
+
+	// Auth0
+	"Auth0::Organization": true,
+	"Auth0::Role":         true,
+	"Auth0::Tenant":       true,
+	"Auth0::User":         true,
+
+	// DataStax
+	"DataStax::Organization": true,
+	"DataStax::Role":         true,
+
+	// Snowflake
+	"Snowflake::Account":      true,
+	"Snowflake::AccountRole":  true,
+	"Snowflake::Database":     true,
+	"Snowflake::DatabaseRole": true,
+}
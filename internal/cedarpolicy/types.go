@@ -0,0 +1,175 @@
+package cedarpolicy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = TextType{}
+	_ basetypes.StringValuableWithSemanticEquals = Text{}
+	_ basetypes.StringTypable                    = ExpressionType{}
+	_ basetypes.StringValuableWithSemanticEquals = Expression{}
+)
+
+// TextType is the type of attributes which hold the Cedar text of a policyset.
+// Texts which only differ in their formatting and comments are semantically equal,
+// so that reformatting done by the API doesn't show up as drift.
+type TextType struct {
+	basetypes.StringType
+}
+
+func (t TextType) String() string {
+	return "cedarpolicy.TextType"
+}
+
+func (t TextType) Equal(o attr.Type) bool {
+	other, ok := o.(TextType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t TextType) ValueType(ctx context.Context) attr.Value {
+	return Text{}
+}
+
+func (t TextType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Text{StringValue: in}, nil
+}
+
+func (t TextType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	v, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := v.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", v)
+	}
+	return Text{StringValue: s}, nil
+}
+
+// Text is the Cedar text of a policyset.
+type Text struct {
+	basetypes.StringValue
+}
+
+func NewTextValue(s string) Text {
+	return Text{StringValue: basetypes.NewStringValue(s)}
+}
+
+func NewTextNull() Text {
+	return Text{StringValue: basetypes.NewStringNull()}
+}
+
+func (v Text) Type(ctx context.Context) attr.Type {
+	return TextType{}
+}
+
+func (v Text) Equal(o attr.Value) bool {
+	other, ok := o.(Text)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v Text) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Text)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return Equal(v.ValueString(), newValue.ValueString()), diags
+}
+
+// ExpressionType is the type of attributes which hold a Cedar expression, such as a selector's when clause.
+// Expressions which only differ in their formatting are semantically equal.
+type ExpressionType struct {
+	basetypes.StringType
+}
+
+func (t ExpressionType) String() string {
+	return "cedarpolicy.ExpressionType"
+}
+
+func (t ExpressionType) Equal(o attr.Type) bool {
+	other, ok := o.(ExpressionType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t ExpressionType) ValueType(ctx context.Context) attr.Value {
+	return Expression{}
+}
+
+func (t ExpressionType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Expression{StringValue: in}, nil
+}
+
+func (t ExpressionType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	v, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := v.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", v)
+	}
+	return Expression{StringValue: s}, nil
+}
+
+// Expression is a Cedar expression.
+type Expression struct {
+	basetypes.StringValue
+}
+
+func NewExpressionValue(s string) Expression {
+	return Expression{StringValue: basetypes.NewStringValue(s)}
+}
+
+func NewExpressionNull() Expression {
+	return Expression{StringValue: basetypes.NewStringNull()}
+}
+
+func (v Expression) Type(ctx context.Context) attr.Type {
+	return ExpressionType{}
+}
+
+func (v Expression) Equal(o attr.Value) bool {
+	other, ok := o.(Expression)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v Expression) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Expression)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return EqualExpressions(v.ValueString(), newValue.ValueString()), diags
+}
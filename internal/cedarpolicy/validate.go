@@ -0,0 +1,190 @@
+// Package cedarpolicy checks Cedar policies locally, so that mistakes in them are reported by terraform validate
+// rather than by the Common Fate API when the policies are applied.
+package cedarpolicy
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cedar-policy/cedar-go"
+	publicast "github.com/cedar-policy/cedar-go/ast"
+	"github.com/cedar-policy/cedar-go/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// parseError matches the location of an error returned by the Cedar parser, e.g.
+// 'parser error: parse error at <input>:1:6 "(": unexpected effect: allow'.
+var parseError = regexp.MustCompile(`^parser error: (parse error at )?<input>:(\d+):(\d+):? (.*)$`)
+
+// Parse parses Cedar policy text, returning an error which includes the line and column of any syntax error.
+func Parse(text string) (cedar.PolicyList, error) {
+	policies, err := cedar.NewPolicyListFromBytes("", []byte(text))
+	if err != nil {
+		m := parseError.FindStringSubmatch(err.Error())
+		if m == nil {
+			return nil, err
+		}
+		perr := &ParseError{Message: m[4]}
+		perr.Line, _ = strconv.Atoi(m[2])
+		perr.Column, _ = strconv.Atoi(m[3])
+		if m[1] != "" {
+			perr.Message = "near " + perr.Message
+		}
+		return nil, perr
+	}
+	return policies, nil
+}
+
+// Validate parses Cedar policy text and checks the entity types and actions it refers to against the Common Fate schema.
+// Problems are reported against the attribute at p.
+func Validate(p path.Path, text string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	policies, err := Parse(text)
+	if err != nil {
+		diags.AddAttributeError(p, "Invalid Cedar Policy", fmt.Sprintf("The policy text could not be parsed: %s.", err))
+		return diags
+	}
+
+	for _, policy := range policies {
+		pos := policy.Position()
+		diags.Append(checkSchema(p, policy, fmt.Sprintf("the policy at line %d, column %d", pos.Line, pos.Column))...)
+	}
+
+	return diags
+}
+
+// ValidatePolicy checks a structured policy, reporting syntax errors in its when and unless expressions
+// against those attributes below p, and otherwise checking it against the schema in the same way as Validate.
+func ValidatePolicy(p path.Path, policy Policy) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, c := range []struct{ name, expr string }{{"when", policy.When}, {"unless", policy.Unless}} {
+		if c.expr == "" {
+			continue
+		}
+		if _, err := ParseExpression(c.expr); err != nil {
+			diags.AddAttributeError(p.AtName(c.name), "Invalid Cedar Expression", fmt.Sprintf("The expression could not be parsed: %s.", err))
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	node, err := policy.toAST()
+	if err != nil {
+		diags.AddAttributeError(p, "Invalid Policy", err.Error())
+		return diags
+	}
+
+	return checkSchema(p, cedar.NewPolicyFromAST((*publicast.Policy)(node)), "the policy")
+}
+
+// checkSchema checks the actions and entity types which the policy refers to. where describes the policy in the diagnostics.
+func checkSchema(p path.Path, policy *cedar.Policy, where string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	uids, refs := references(policy)
+
+	for _, uid := range uids {
+		if uid.Type == actionType && !actions[uid.ID] {
+			diags.AddAttributeError(p, "Unknown Cedar Action", fmt.Sprintf("%s refers to %s, which is not an action in the Common Fate schema. Valid actions are: %s.", where, uid, validActions()))
+		}
+	}
+
+	for _, t := range refs {
+		if entityTypes[t] {
+			continue
+		}
+		if isCoreType(t) {
+			diags.AddAttributeError(p, "Unknown Cedar Entity Type", fmt.Sprintf("%s refers to the entity type %s, which is not in the Common Fate schema.", where, t))
+		} else {
+			diags.AddAttributeWarning(p, "Unknown Cedar Entity Type", fmt.Sprintf("%s refers to the entity type %s, which is not in the Common Fate schema known to this provider. "+
+				"If the type is registered by an integration this warning can be ignored, otherwise the policy will never match.", where, t))
+		}
+	}
+
+	return diags
+}
+
+func isCoreType(t types.EntityType) bool {
+	namespace, _, _ := strings.Cut(string(t), "::")
+	for _, ns := range coreNamespaces {
+		if namespace == ns {
+			return true
+		}
+	}
+	return false
+}
+
+func validActions() string {
+	var out []string
+	for a := range actions {
+		out = append(out, types.NewEntityUID(actionType, a).String())
+	}
+	sort.Strings(out)
+	return strings.Join(out, ", ")
+}
+
+var (
+	entityUIDType  = reflect.TypeOf(types.EntityUID{})
+	entityTypeType = reflect.TypeOf(types.EntityType(""))
+)
+
+// references returns the entities and the entity types referred to anywhere in the policy, in the order they appear.
+// An entity's type is included in the entity types.
+func references(policy *cedar.Policy) ([]types.EntityUID, []types.EntityType) {
+	var uids []types.EntityUID
+	var entityTypes []types.EntityType
+	seen := map[types.EntityType]bool{}
+
+	addType := func(t types.EntityType) {
+		if !seen[t] {
+			seen[t] = true
+			entityTypes = append(entityTypes, t)
+		}
+	}
+
+	// the AST is made of many node types, so rather than switching on each of them
+	// it is walked with reflection, picking out the entity references wherever they are.
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		switch v.Kind() {
+		case reflect.Pointer, reflect.Interface:
+			if !v.IsNil() {
+				walk(v.Elem())
+			}
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < v.Len(); i++ {
+				walk(v.Index(i))
+			}
+		case reflect.Map:
+			iter := v.MapRange()
+			for iter.Next() {
+				walk(iter.Value())
+			}
+		case reflect.String:
+			if v.Type() == entityTypeType {
+				addType(types.EntityType(v.String()))
+			}
+		case reflect.Struct:
+			if v.Type() == entityUIDType {
+				uid := types.NewEntityUID(types.EntityType(v.FieldByName("Type").String()), types.String(v.FieldByName("ID").String()))
+				uids = append(uids, uid)
+				addType(uid.Type)
+				return
+			}
+			for i := 0; i < v.NumField(); i++ {
+				walk(v.Field(i))
+			}
+		}
+	}
+	walk(reflect.ValueOf(policy.AST()))
+
+	return uids, entityTypes
+}
@@ -0,0 +1,94 @@
+package cedarpolicy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name         string
+		text         string
+		wantErrors   []string
+		wantWarnings []string
+	}{
+		{
+			name: "valid",
+			text: `permit(principal, action, resource);`,
+		},
+		{
+			name: "empty",
+			text: ``,
+		},
+		{
+			name: "known entity types and actions",
+			text: `
+permit (
+  principal in CF::Group::"admins",
+  action in [Access::Action::"Request", Access::Action::"Activate"],
+  resource is AWS::Account
+)
+when { resource in AWS::OrgUnit::"ou-123" && context.request.principal == CF::User::"usr_123" };
+`,
+		},
+		{
+			name:       "syntax error reports line and column",
+			text:       "permit(\n  principal,\n  action == Access::Action::\"Request\"\n  resource\n);",
+			wantErrors: []string{`line 5, column 1: near ")"`},
+		},
+		{
+			name:       "unterminated string",
+			text:       `permit(principal, action, resource) when { resource.name == "prod };`,
+			wantErrors: []string{"line 1, column 61: literal not terminated"},
+		},
+		{
+			name:       "unknown action",
+			text:       `permit(principal, action == Access::Action::"Reqest", resource);`,
+			wantErrors: []string{`the policy at line 1, column 1 refers to Access::Action::"Reqest", which is not an action`},
+		},
+		{
+			name:       "unknown action in condition",
+			text:       "permit(principal, action, resource);\n\nforbid(principal, action, resource) unless { action == Access::Action::\"Aprove\" };",
+			wantErrors: []string{`the policy at line 3, column 1 refers to Access::Action::"Aprove"`},
+		},
+		{
+			name:       "unknown core entity type",
+			text:       `permit(principal is CF::Usr, action, resource);`,
+			wantErrors: []string{"refers to the entity type CF::Usr, which is not in the Common Fate schema"},
+		},
+		{
+			name:         "unknown integration entity type",
+			text:         `permit(principal, action, resource in AWS::Acount::"123456789012");`,
+			wantWarnings: []string{"refers to the entity type AWS::Acount"},
+		},
+		{
+			name:         "entity types in sets are checked",
+			text:         `permit(principal, action, resource) when { [Foo::"a"].contains(resource) };`,
+			wantWarnings: []string{"refers to the entity type Foo"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := Validate(path.Root("text"), tt.text)
+
+			assertDiags(t, "error", diags.Errors(), tt.wantErrors)
+			assertDiags(t, "warning", diags.Warnings(), tt.wantWarnings)
+		})
+	}
+}
+
+func assertDiags(t *testing.T, kind string, got diag.Diagnostics, want []string) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d %ss, want %d: %v", len(got), kind, len(want), got)
+	}
+	for i, d := range got {
+		if !strings.Contains(d.Detail(), want[i]) {
+			t.Errorf("%s %d = %q, want it to contain %q", kind, i, d.Detail(), want[i])
+		}
+	}
+}
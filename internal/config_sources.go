@@ -0,0 +1,139 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+	config_client "github.com/common-fate/sdk/config"
+)
+
+// configSource loads a config value by key, like the config sources in the Common Fate SDK.
+type configSource interface {
+	Load(key config_client.Key) (string, error)
+}
+
+// newConfigSources returns the sources named in the provider's config_sources attribute.
+// Valid names are 'env', 'file', or a URL to fetch deployment config from.
+//
+// If contextName is set, 'file' loads that context from the Common Fate config file,
+// rather than the file's current context.
+func newConfigSources(names []string, contextName string) ([]configSource, error) {
+	var sources []configSource
+
+	for _, name := range names {
+		switch name {
+		case "env":
+			sources = append(sources, config_client.EnvSource{})
+		case "file":
+			if contextName != "" {
+				sources = append(sources, &contextSource{name: contextName})
+			} else {
+				sources = append(sources, &config_client.FileSource{})
+			}
+		default:
+			u, err := url.Parse(name)
+			if err != nil || u.Scheme == "" || u.Host == "" {
+				return nil, fmt.Errorf("invalid config source %q (valid sources are 'env', 'file', or a URL)", name)
+			}
+			sources = append(sources, &config_client.URLSource{DeploymentURL: u})
+		}
+	}
+
+	return sources, nil
+}
+
+// loadConfigSources fills in any values which aren't set in opts from the sources,
+// trying each source in turn.
+func loadConfigSources(opts *config_client.Opts, sources []configSource) error {
+	values := []struct {
+		key   config_client.Key
+		value *string
+	}{
+		{config_client.APIURLKey, &opts.APIURL},
+		{config_client.AuthzURLKey, &opts.AuthzURL},
+		{config_client.AccessURLKey, &opts.AccessURL},
+		{config_client.OIDCClientIDKey, &opts.ClientID},
+		{config_client.OIDCClientSecretKey, &opts.ClientSecret},
+		{config_client.OIDCIssuerKey, &opts.OIDCIssuer},
+	}
+
+	for _, v := range values {
+		for _, source := range sources {
+			if *v.value != "" {
+				break
+			}
+			loaded, err := source.Load(v.key)
+			if err != nil {
+				return err
+			}
+			*v.value = loaded
+		}
+	}
+
+	return nil
+}
+
+// contextSource loads config values from a named context in the Common Fate config file,
+// which is ~/.cf/config unless the CF_CONFIG_FILE environment variable is set.
+type contextSource struct {
+	name   string
+	loaded *config_client.Context
+}
+
+func (s *contextSource) Load(key config_client.Key) (string, error) {
+	if s.loaded == nil {
+		c, err := loadContext(s.name)
+		if err != nil {
+			return "", err
+		}
+		s.loaded = c
+	}
+
+	switch key {
+	case config_client.APIURLKey:
+		return s.loaded.APIURL, nil
+	case config_client.AuthzURLKey:
+		return s.loaded.AuthzURL, nil
+	case config_client.AccessURLKey:
+		return s.loaded.AccessURL, nil
+	case config_client.OIDCIssuerKey:
+		return s.loaded.OIDCIssuer, nil
+	case config_client.OIDCClientIDKey:
+		return s.loaded.OIDCClientID, nil
+	case config_client.OIDCClientSecretKey:
+		return s.loaded.OIDCClientSecret, nil
+	}
+
+	return "", nil
+}
+
+func loadContext(name string) (*config_client.Context, error) {
+	path := os.Getenv("CF_CONFIG_FILE")
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		path = filepath.Join(home, ".cf", "config")
+	}
+
+	var file config_client.Config
+	_, err := toml.DecodeFile(path, &file)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("context %q was requested, but the Common Fate config file %s does not exist. If you're using the Common Fate CLI, use 'cf configure' to set it up", name, path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading Common Fate config file %s: %w", path, err)
+	}
+
+	c, ok := file.Contexts[name]
+	if !ok {
+		return nil, fmt.Errorf("could not find context %q in Common Fate config file %s", name, path)
+	}
+	return &c, nil
+}
@@ -0,0 +1,57 @@
+package datastax_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+const dataStaxIntegrationDatasourceResource = `
+resource "commonfate_datastax_integration" "test" {
+  name                = "test"
+  api_key_secret_path = "/secret"
+}
+`
+
+func TestAccDataStaxIntegrationDatasource(t *testing.T) {
+	acctest.RunDatasourceTests(t, []acctest.DatasourceTest{
+		{
+			Name:       "by_id",
+			Datasource: "data.commonfate_datastax_integration.test",
+			Config: dataStaxIntegrationDatasourceResource + `
+data "commonfate_datastax_integration" "test" {
+  id = commonfate_datastax_integration.test.id
+}
+`,
+			Check: map[string]string{
+				"name":                "test",
+				"api_key_secret_path": "/secret",
+			},
+		},
+		{
+			Name:       "by_name",
+			Datasource: "data.commonfate_datastax_integration.test",
+			Config: dataStaxIntegrationDatasourceResource + `
+data "commonfate_datastax_integration" "test" {
+  name = "test"
+
+  depends_on = [commonfate_datastax_integration.test]
+}
+`,
+			Check: map[string]string{
+				"name":                "test",
+				"api_key_secret_path": "/secret",
+			},
+		},
+		{
+			Name: "not_found",
+			Config: `
+data "commonfate_datastax_integration" "test" {
+  name = "missing"
+}
+`,
+			ExpectError: regexp.MustCompile(`DataStax Integration Not Found`),
+		},
+	})
+}
@@ -0,0 +1,43 @@
+package datastax_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccDataStaxOrganizationAvailabilities(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_datastax_organization_availabilities.test",
+			Config: `
+resource "commonfate_datastax_organization_availabilities" "test" {
+  workflow_id                       = "aw_123"
+  datastax_organization_selector_id = "sel_123"
+  datastax_organization_id          = "org-123"
+  role_id                           = "role-123"
+}
+`,
+			Check: map[string]string{
+				"workflow_id":                       "aw_123",
+				"datastax_organization_selector_id": "sel_123",
+				"datastax_organization_id":          "org-123",
+				"role_id":                           "role-123",
+			},
+			UpdatedConfig: `
+resource "commonfate_datastax_organization_availabilities" "test" {
+  workflow_id                       = "aw_456"
+  datastax_organization_selector_id = "sel_123"
+  datastax_organization_id          = "org-123"
+  role_id                           = "role-123"
+  role_priority                     = 10
+}
+`,
+			UpdatedCheck: map[string]string{
+				"workflow_id":   "aw_456",
+				"role_priority": "10",
+			},
+		},
+	})
+}
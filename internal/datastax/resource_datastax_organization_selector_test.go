@@ -0,0 +1,38 @@
+package datastax_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccDataStaxOrganizationSelector(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_datastax_organization_selector.test",
+			Config: `
+resource "commonfate_datastax_organization_selector" "test" {
+  id                       = "test"
+  name                     = "Test"
+  datastax_organization_id = "org-123"
+}
+`,
+			Check: map[string]string{
+				"id":                       "test",
+				"name":                     "Test",
+				"datastax_organization_id": "org-123",
+			},
+			UpdatedConfig: `
+resource "commonfate_datastax_organization_selector" "test" {
+  id                       = "test"
+  name                     = "Test Updated"
+  datastax_organization_id = "org-123"
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name": "Test Updated",
+			},
+		},
+	})
+}
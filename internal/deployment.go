@@ -0,0 +1,48 @@
+package internal
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"connectrpc.com/connect"
+	config_client "github.com/common-fate/sdk/config"
+	configv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1/configv1alpha1connect"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// loadDeployment fetches the config of the deployment the provider is configured for,
+// and fingerprints it so resources can tell which deployment they belong to.
+//
+// The fingerprint is made from the deployment's OIDC issuer and SAML entity ID, which don't change over
+// the life of a deployment. It doesn't include the API URL, so that a deployment which moves to a new domain
+// keeps its fingerprint, or the Terraform OIDC client ID, so that the client can be rotated.
+// If the config can't be fetched, the fingerprint is left empty, resources skip their deployment checks,
+// and a warning is returned so that this doesn't happen silently.
+func loadDeployment(ctx context.Context, cfg *config_client.Context) (providerdata.Deployment, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	deployment := providerdata.Deployment{APIURL: cfg.APIURL}
+
+	client := configv1alpha1connect.NewDeploymentServiceClient(cfg.HTTPClient, cfg.APIURL)
+	res, err := client.GetDeploymentConfig(ctx, connect.NewRequest(&configv1alpha1.GetDeploymentConfigRequest{}))
+	if err != nil {
+		diags.AddWarning(
+			"Unable to Identify Common Fate Deployment",
+			fmt.Sprintf("Could not fetch the config of the Common Fate deployment at %s, so resources won't check that they belong to this deployment before they are changed.\n\n%s", cfg.APIURL, err),
+		)
+		return deployment, diags
+	}
+
+	config := res.Msg.DeploymentConfig
+	h := sha256.New()
+	for _, v := range []string{config.GetOidcIssuer(), config.GetSamlSsoEntityId()} {
+		h.Write([]byte(v))
+		h.Write([]byte{0})
+	}
+	deployment.Fingerprint = hex.EncodeToString(h.Sum(nil))[:32]
+
+	return deployment, diags
+}
@@ -0,0 +1,62 @@
+// Package duration provides a Terraform attribute type for human-readable durations such as "8h" or "1h30m".
+package duration
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Parse parses a duration string such as "8h", "30m" or "1h30m".
+// A bare number is read as a number of seconds, so that configuration written
+// before the duration attributes accepted strings keeps working.
+func Parse(s string) (time.Duration, error) {
+	if secs, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if secs < 0 {
+			return 0, fmt.Errorf("duration %q must not be negative", s)
+		}
+		return time.Duration(secs) * time.Second, nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a valid duration, expected a value such as \"8h\", \"30m\" or \"1h30m\"", s)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("duration %q must not be negative", s)
+	}
+	return d, nil
+}
+
+// Format formats d without its zero units, e.g. "8h" rather than "8h0m0s".
+func Format(d time.Duration) string {
+	if d == 0 {
+		return "0s"
+	}
+	if d%time.Second != 0 {
+		return d.String()
+	}
+
+	var b strings.Builder
+	if h := d / time.Hour; h > 0 {
+		fmt.Fprintf(&b, "%dh", h)
+	}
+	if m := d % time.Hour / time.Minute; m > 0 {
+		fmt.Fprintf(&b, "%dm", m)
+	}
+	if s := d % time.Minute / time.Second; s > 0 {
+		fmt.Fprintf(&b, "%ds", s)
+	}
+	return b.String()
+}
+
+// FromAPI converts an optional API duration to a Value, or null if it isn't set.
+func FromAPI(d *durationpb.Duration) Value {
+	if d == nil {
+		return NewNull()
+	}
+	return NewValue(d.AsDuration())
+}
@@ -0,0 +1,62 @@
+package duration
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{in: "8h", want: 8 * time.Hour},
+		{in: "30m", want: 30 * time.Minute},
+		{in: "1h30m", want: 90 * time.Minute},
+		{in: "28800", want: 8 * time.Hour},
+		{in: "0", want: 0},
+		{in: "", wantErr: true},
+		{in: "8 hours", wantErr: true},
+		{in: "1d", wantErr: true},
+		{in: "-1h", wantErr: true},
+		{in: "-60", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := Parse(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Parse(%q) = %s, want %s", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{in: 0, want: "0s"},
+		{in: 8 * time.Hour, want: "8h"},
+		{in: 90 * time.Minute, want: "1h30m"},
+		{in: 2000 * time.Second, want: "33m20s"},
+		{in: 26*time.Hour + 5*time.Second, want: "26h5s"},
+		{in: 1500 * time.Millisecond, want: "1.5s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := Format(tt.in); got != tt.want {
+				t.Errorf("Format(%s) = %q, want %q", tt.in, got, tt.want)
+			}
+			if got, err := Parse(Format(tt.in)); err != nil || got != tt.in {
+				t.Errorf("Parse(Format(%s)) = %s, %v", tt.in, got, err)
+			}
+		})
+	}
+}
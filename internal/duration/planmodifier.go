@@ -0,0 +1,47 @@
+package duration
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// UseStateForEquivalentDefault keeps the prior state value of an attribute which isn't configured
+// when it is the same length as the planned default, e.g. "0" in state and a default of "0s".
+// Without it, state written before the attribute held a duration string would show a diff.
+//
+// Configured values aren't changed, as Terraform requires the plan to match the configuration.
+func UseStateForEquivalentDefault() planmodifier.String {
+	return useStateForEquivalentDefault{}
+}
+
+type useStateForEquivalentDefault struct{}
+
+func (m useStateForEquivalentDefault) Description(_ context.Context) string {
+	return "Keeps the prior state value when the attribute isn't configured and the default is the same duration."
+}
+
+func (m useStateForEquivalentDefault) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m useStateForEquivalentDefault) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.ConfigValue.IsNull() || req.StateValue.IsNull() || req.StateValue.IsUnknown() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+	if req.StateValue.ValueString() == req.PlanValue.ValueString() {
+		return
+	}
+
+	state, err := Parse(req.StateValue.ValueString())
+	if err != nil {
+		return
+	}
+	plan, err := Parse(req.PlanValue.ValueString())
+	if err != nil {
+		return
+	}
+	if state == plan {
+		resp.PlanValue = req.StateValue
+	}
+}
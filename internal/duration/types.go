@@ -0,0 +1,145 @@
+package duration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var (
+	_ basetypes.StringTypable                    = Type{}
+	_ xattr.TypeWithValidate                     = Type{}
+	_ basetypes.StringValuableWithSemanticEquals = Value{}
+)
+
+// Type is the type of attributes which hold a duration, such as "8h" or "1h30m".
+// Durations which are the same length are semantically equal, so "90m" doesn't
+// show up as drift when the API returns it as 5400 seconds.
+type Type struct {
+	basetypes.StringType
+}
+
+func (t Type) String() string {
+	return "duration.Type"
+}
+
+func (t Type) Equal(o attr.Type) bool {
+	other, ok := o.(Type)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t Type) ValueType(ctx context.Context) attr.Value {
+	return Value{}
+}
+
+func (t Type) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Value{StringValue: in}, nil
+}
+
+func (t Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	v, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := v.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", v)
+	}
+	return Value{StringValue: s}, nil
+}
+
+// Validate checks that the value can be parsed as a duration.
+func (t Type) Validate(ctx context.Context, in tftypes.Value, p path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var s string
+	if err := in.As(&s); err != nil {
+		diags.AddAttributeError(p, "Invalid Duration", err.Error())
+		return diags
+	}
+	if _, err := Parse(s); err != nil {
+		diags.AddAttributeError(p, "Invalid Duration", err.Error())
+	}
+	return diags
+}
+
+// Value is a duration.
+type Value struct {
+	basetypes.StringValue
+}
+
+// NewValue returns a Value holding d, formatted without its zero units.
+func NewValue(d time.Duration) Value {
+	return Value{StringValue: basetypes.NewStringValue(Format(d))}
+}
+
+func NewNull() Value {
+	return Value{StringValue: basetypes.NewStringNull()}
+}
+
+func (v Value) Type(ctx context.Context) attr.Type {
+	return Type{}
+}
+
+func (v Value) Equal(o attr.Value) bool {
+	other, ok := o.(Value)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Duration returns the parsed duration, or zero if the value is null, unknown or invalid.
+// Invalid values are rejected by Type.Validate before they reach the provider.
+func (v Value) Duration() time.Duration {
+	if v.IsNull() || v.IsUnknown() {
+		return 0
+	}
+	d, _ := Parse(v.ValueString())
+	return d
+}
+
+// ToAPI converts the value to an API duration, or nil if it is null.
+func (v Value) ToAPI() *durationpb.Duration {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	return durationpb.New(v.Duration())
+}
+
+func (v Value) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Value)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	a, err := Parse(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	b, err := Parse(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	return a == b, diags
+}
@@ -0,0 +1,120 @@
+package entra
+
+import (
+	"context"
+	"fmt"
+
+	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
+	"github.com/common-fate/sdk/service/control/integration"
+	"github.com/common-fate/terraform-provider-commonfate/internal/integrations"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type EntraIntegrationDatasourceModel struct {
+	Id                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	TenantID               types.String `tfsdk:"tenant_id"`
+	ClientID               types.String `tfsdk:"client_id"`
+	ClientSecretSecretPath types.String `tfsdk:"client_secret_secret_path"`
+}
+
+// EntraIntegrationDatasource looks up an existing Microsoft Entra integration by ID or name.
+type EntraIntegrationDatasource struct {
+	client integrationv1alpha1connect.IntegrationServiceClient
+}
+
+var (
+	_ datasource.DataSource                     = &EntraIntegrationDatasource{}
+	_ datasource.DataSourceWithConfigValidators = &EntraIntegrationDatasource{}
+)
+
+// Metadata returns the data source type name.
+func (r *EntraIntegrationDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entra_integration"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *EntraIntegrationDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	cfg := providerData.Config
+
+	r.client = integration.NewFromConfig(cfg)
+}
+
+func (r *EntraIntegrationDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing Microsoft Entra integration by ID or name.",
+		Attributes: integrations.LookupAttributes("Microsoft Entra", map[string]schema.Attribute{
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "The Microsoft Entra Tenant ID",
+				Computed:            true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The Microsoft Entra application Client ID",
+				Computed:            true,
+			},
+			"client_secret_secret_path": schema.StringAttribute{
+				MarkdownDescription: "Path to secret for the Microsoft Entra Client Secret",
+				Computed:            true,
+			},
+		}),
+	}
+}
+
+func (r *EntraIntegrationDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return integrations.ConfigValidators()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *EntraIntegrationDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HTTP Client",
+			"Expected configured HTTP client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data EntraIntegrationDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integ, diags := integrations.Lookup(ctx, r.client, data.Id, data.Name, "Microsoft Entra", func(c *integrationv1alpha1.Config) bool {
+		return c.GetEntra() != nil
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := integ.Config.GetEntra()
+
+	state := EntraIntegrationDatasourceModel{
+		Id:                     types.StringValue(integ.Id),
+		Name:                   types.StringValue(integ.Name),
+		TenantID:               types.StringValue(config.TenantId),
+		ClientID:               types.StringValue(config.ClientId),
+		ClientSecretSecretPath: types.StringValue(config.ClientSecretSecretPath),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
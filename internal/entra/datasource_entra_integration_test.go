@@ -0,0 +1,63 @@
+package entra_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+const entraIntegrationDatasourceResource = `
+resource "commonfate_entra_integration" "test" {
+  name                      = "test"
+  tenant_id                 = "tenant-123"
+  client_id                 = "client"
+  client_secret_secret_path = "/secret"
+}
+`
+
+func TestAccEntraIntegrationDatasource(t *testing.T) {
+	acctest.RunDatasourceTests(t, []acctest.DatasourceTest{
+		{
+			Name:       "by_id",
+			Datasource: "data.commonfate_entra_integration.test",
+			Config: entraIntegrationDatasourceResource + `
+data "commonfate_entra_integration" "test" {
+  id = commonfate_entra_integration.test.id
+}
+`,
+			Check: map[string]string{
+				"name":                      "test",
+				"tenant_id":                 "tenant-123",
+				"client_id":                 "client",
+				"client_secret_secret_path": "/secret",
+			},
+		},
+		{
+			Name:       "by_name",
+			Datasource: "data.commonfate_entra_integration.test",
+			Config: entraIntegrationDatasourceResource + `
+data "commonfate_entra_integration" "test" {
+  name = "test"
+
+  depends_on = [commonfate_entra_integration.test]
+}
+`,
+			Check: map[string]string{
+				"name":                      "test",
+				"tenant_id":                 "tenant-123",
+				"client_id":                 "client",
+				"client_secret_secret_path": "/secret",
+			},
+		},
+		{
+			Name: "not_found",
+			Config: `
+data "commonfate_entra_integration" "test" {
+  name = "missing"
+}
+`,
+			ExpectError: regexp.MustCompile(`Microsoft Entra Integration Not Found`),
+		},
+	})
+}
@@ -0,0 +1,40 @@
+package entra_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccEntraGroupAvailabilities(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_entra_group_availabilities.test",
+			Config: `
+resource "commonfate_entra_group_availabilities" "test" {
+  workflow_id             = "aw_123"
+  entra_group_selector_id = "sel_123"
+  tenant_id               = "tenant-123"
+}
+`,
+			Check: map[string]string{
+				"workflow_id":             "aw_123",
+				"entra_group_selector_id": "sel_123",
+				"tenant_id":               "tenant-123",
+			},
+			UpdatedConfig: `
+resource "commonfate_entra_group_availabilities" "test" {
+  workflow_id             = "aw_456"
+  entra_group_selector_id = "sel_123"
+  tenant_id               = "tenant-123"
+  role_priority           = 10
+}
+`,
+			UpdatedCheck: map[string]string{
+				"workflow_id":   "aw_456",
+				"role_priority": "10",
+			},
+		},
+	})
+}
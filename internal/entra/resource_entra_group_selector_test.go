@@ -0,0 +1,42 @@
+package entra_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccEntraGroupSelector(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_entra_group_selector.test",
+			Config: `
+resource "commonfate_entra_group_selector" "test" {
+  id        = "test"
+  name      = "Test"
+  tenant_id = "tenant-123"
+  when      = "resource.name like \"*admins*\""
+}
+`,
+			Check: map[string]string{
+				"id":        "test",
+				"name":      "Test",
+				"tenant_id": "tenant-123",
+				"when":      "resource.name like \"*admins*\"",
+			},
+			UpdatedConfig: `
+resource "commonfate_entra_group_selector" "test" {
+  id        = "test"
+  name      = "Test Updated"
+  tenant_id = "tenant-123"
+  when      = "true"
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name": "Test Updated",
+				"when": "true",
+			},
+		},
+	})
+}
@@ -0,0 +1,42 @@
+package entra_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccEntraIntegration(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_entra_integration.test",
+			Config: `
+resource "commonfate_entra_integration" "test" {
+  name                      = "test"
+  tenant_id                 = "tenant-123"
+  client_id                 = "client"
+  client_secret_secret_path = "/secret"
+}
+`,
+			Check: map[string]string{
+				"name":                      "test",
+				"tenant_id":                 "tenant-123",
+				"client_id":                 "client",
+				"client_secret_secret_path": "/secret",
+			},
+			UpdatedConfig: `
+resource "commonfate_entra_integration" "test" {
+  name                      = "test-updated"
+  tenant_id                 = "tenant-123"
+  client_id                 = "client-updated"
+  client_secret_secret_path = "/secret"
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name":      "test-updated",
+				"client_id": "client-updated",
+			},
+		},
+	})
+}
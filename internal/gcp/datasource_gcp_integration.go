@@ -0,0 +1,139 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/common-fate/grab"
+	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
+	"github.com/common-fate/sdk/service/control/integration"
+	"github.com/common-fate/terraform-provider-commonfate/internal/integrations"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type GCPIntegrationDatasourceModel struct {
+	Id                                             types.String `tfsdk:"id"`
+	Name                                           types.String `tfsdk:"name"`
+	ReaderWorkloadIdentityConfig                   types.String `tfsdk:"reader_workload_identity_config"`
+	ReaderServiceAccountCredentialsSecretPath      types.String `tfsdk:"reader_service_account_credentials_secret_path"`
+	OrganizationID                                 types.String `tfsdk:"organization_id"`
+	GoogleWorkspaceCustomerID                      types.String `tfsdk:"google_workspace_customer_id"`
+	ProvisionerWorkloadIdentityConfig              types.String `tfsdk:"provisioner_workload_identity_config"`
+	ProvisionerServiceAccountCredentialsSecretPath types.String `tfsdk:"provisioner_service_account_credentials_secret_path"`
+}
+
+// GCPIntegrationDatasource looks up an existing GCP integration by ID or name.
+type GCPIntegrationDatasource struct {
+	client integrationv1alpha1connect.IntegrationServiceClient
+}
+
+var (
+	_ datasource.DataSource                     = &GCPIntegrationDatasource{}
+	_ datasource.DataSourceWithConfigValidators = &GCPIntegrationDatasource{}
+)
+
+// Metadata returns the data source type name.
+func (r *GCPIntegrationDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gcp_integration"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *GCPIntegrationDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	cfg := providerData.Config
+
+	r.client = integration.NewFromConfig(cfg)
+}
+
+func (r *GCPIntegrationDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing GCP integration by ID or name.",
+		Attributes: integrations.LookupAttributes("GCP", map[string]schema.Attribute{
+			"reader_workload_identity_config": schema.StringAttribute{
+				MarkdownDescription: "GCP Workload Identity Config used to read resources, as a JSON string",
+				Computed:            true,
+			},
+			"reader_service_account_credentials_secret_path": schema.StringAttribute{
+				MarkdownDescription: "Path to secret for the Service account credentials used to read resources",
+				Computed:            true,
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "GCP organization ID",
+				Computed:            true,
+			},
+			"google_workspace_customer_id": schema.StringAttribute{
+				MarkdownDescription: "The Google Workspace Customer ID",
+				Computed:            true,
+			},
+			"provisioner_workload_identity_config": schema.StringAttribute{
+				MarkdownDescription: "GCP Workload Identity Config used to provision access, as a JSON string",
+				Computed:            true,
+			},
+			"provisioner_service_account_credentials_secret_path": schema.StringAttribute{
+				MarkdownDescription: "Path to secret for the Service account credentials used to provision access",
+				Computed:            true,
+			},
+		}),
+	}
+}
+
+func (r *GCPIntegrationDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return integrations.ConfigValidators()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *GCPIntegrationDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HTTP Client",
+			"Expected configured HTTP client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data GCPIntegrationDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integ, diags := integrations.Lookup(ctx, r.client, data.Id, data.Name, "GCP", func(c *integrationv1alpha1.Config) bool {
+		return c.GetGcp() != nil
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := integ.Config.GetGcp()
+
+	state := GCPIntegrationDatasourceModel{
+		Id:                           types.StringValue(integ.Id),
+		Name:                         types.StringValue(integ.Name),
+		ReaderWorkloadIdentityConfig: grab.If(config.ReaderWorkloadIdentityConfig == "", types.StringNull(), types.StringValue(config.ReaderWorkloadIdentityConfig)),
+		ReaderServiceAccountCredentialsSecretPath: grab.If(config.ReaderServiceAccountCredentialsSecretPath == "", types.StringNull(), types.StringValue(config.ReaderServiceAccountCredentialsSecretPath)),
+		OrganizationID:                                 types.StringValue(config.OrganizationId),
+		GoogleWorkspaceCustomerID:                      types.StringValue(config.GoogleWorkspaceCustomerId),
+		ProvisionerWorkloadIdentityConfig:              grab.If(config.ProvisionerWorkloadIdentityConfig == "", types.StringNull(), types.StringValue(config.ProvisionerWorkloadIdentityConfig)),
+		ProvisionerServiceAccountCredentialsSecretPath: grab.If(config.ProvisionerServiceAccountCredentialsSecretPath == "", types.StringNull(), types.StringValue(config.ProvisionerServiceAccountCredentialsSecretPath)),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,63 @@
+package gcp_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+const gcpIntegrationDatasourceResource = `
+resource "commonfate_gcp_integration" "test" {
+  name                                           = "test"
+  organization_id                                = "organizations/1234"
+  google_workspace_customer_id                   = "C123"
+  reader_service_account_credentials_secret_path = "/reader"
+}
+`
+
+func TestAccGCPIntegrationDatasource(t *testing.T) {
+	acctest.RunDatasourceTests(t, []acctest.DatasourceTest{
+		{
+			Name:       "by_id",
+			Datasource: "data.commonfate_gcp_integration.test",
+			Config: gcpIntegrationDatasourceResource + `
+data "commonfate_gcp_integration" "test" {
+  id = commonfate_gcp_integration.test.id
+}
+`,
+			Check: map[string]string{
+				"name":                         "test",
+				"organization_id":              "organizations/1234",
+				"google_workspace_customer_id": "C123",
+				"reader_service_account_credentials_secret_path": "/reader",
+			},
+		},
+		{
+			Name:       "by_name",
+			Datasource: "data.commonfate_gcp_integration.test",
+			Config: gcpIntegrationDatasourceResource + `
+data "commonfate_gcp_integration" "test" {
+  name = "test"
+
+  depends_on = [commonfate_gcp_integration.test]
+}
+`,
+			Check: map[string]string{
+				"name":                         "test",
+				"organization_id":              "organizations/1234",
+				"google_workspace_customer_id": "C123",
+				"reader_service_account_credentials_secret_path": "/reader",
+			},
+		},
+		{
+			Name: "not_found",
+			Config: `
+data "commonfate_gcp_integration" "test" {
+  name = "missing"
+}
+`,
+			ExpectError: regexp.MustCompile(`GCP Integration Not Found`),
+		},
+	})
+}
@@ -0,0 +1,219 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/common-fate/grab"
+	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
+	entityv1alpha1 "github.com/common-fate/sdk/gen/commonfate/entity/v1alpha1"
+	"github.com/common-fate/sdk/service/control/integration"
+	"github.com/common-fate/sdk/service/entity"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type GCPRolesModel struct {
+	IntegrationID types.String   `tfsdk:"gcp_integration_id"`
+	Prefix        types.String   `tfsdk:"prefix"`
+	Roles         []GCPRole      `tfsdk:"roles"`
+	IDs           []types.String `tfsdk:"ids"`
+}
+
+type GCPRole struct {
+	ID          types.String `tfsdk:"id"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Custom      types.Bool   `tfsdk:"custom"`
+}
+
+// GCPRolesDatasource lists the GCP roles which have been synced by a GCP integration.
+type GCPRolesDatasource struct {
+	client   integrationv1alpha1connect.IntegrationServiceClient
+	entities entity.Client
+}
+
+var _ datasource.DataSource = &GCPRolesDatasource{}
+
+// Metadata returns the data source type name.
+func (r *GCPRolesDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gcp_roles"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *GCPRolesDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	cfg := providerData.Config
+
+	r.client = integration.NewFromConfig(cfg)
+	r.entities = entity.NewFromConfig(cfg)
+}
+
+func (r *GCPRolesDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the predefined roles and organization-level custom roles which Common Fate has synced from a GCP integration. Use it to check the role IDs given to `commonfate_gcp_role_group` and the GCP availability resources before they are applied.",
+		Attributes: map[string]schema.Attribute{
+			"gcp_integration_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the `commonfate_gcp_integration` to list roles for.",
+				Required:            true,
+			},
+			"prefix": schema.StringAttribute{
+				MarkdownDescription: "If set, only roles with IDs starting with this prefix are returned, e.g. `roles/bigquery.`.",
+				Optional:            true,
+			},
+			"roles": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching roles, ordered by ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The role ID, e.g. `roles/viewer` or `organizations/1234/roles/custom`.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "The title of the role.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the role, if it has one.",
+							Computed:            true,
+						},
+						"custom": schema.BoolAttribute{
+							MarkdownDescription: "Whether the role is a custom role defined in the organization.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the matching roles, for checking role IDs with `contains()`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *GCPRolesDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HTTP Client",
+			"Expected configured HTTP client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var state GCPRolesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.GetIntegration(ctx, connect.NewRequest(&integrationv1alpha1.GetIntegrationRequest{
+		Id: state.IntegrationID.ValueString(),
+	}))
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		resp.Diagnostics.AddAttributeError(path.Root("gcp_integration_id"), "GCP Integration Not Found", fmt.Sprintf("No integration with ID %q exists.", state.IntegrationID.ValueString()))
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read GCP Integration",
+			err.Error(),
+		)
+		return
+	}
+
+	integ := res.Msg.Integration.Config.GetGcp()
+	if integ == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("gcp_integration_id"), "Wrong Integration Type", fmt.Sprintf("Integration %q exists, but it is not of type GCP.", state.IntegrationID.ValueString()))
+		return
+	}
+
+	all, err := r.entities.All(ctx, entity.ListInput{Type: "GCP::Role"})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list GCP Roles",
+			err.Error(),
+		)
+		return
+	}
+
+	// predefined roles are shared by every organization, while custom roles are
+	// named after the organization which defines them, e.g. organizations/1234/roles/custom.
+	customPrefix := organizationName(integ.OrganizationId) + "/roles/"
+
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Eid.Id < all[j].Eid.Id
+	})
+
+	state.Roles = []GCPRole{}
+	state.IDs = []types.String{}
+	for _, e := range all {
+		id := e.Eid.Id
+		custom := strings.HasPrefix(id, customPrefix)
+		if !custom && !strings.HasPrefix(id, "roles/") {
+			continue
+		}
+		if !strings.HasPrefix(id, state.Prefix.ValueString()) {
+			continue
+		}
+
+		title := stringAttr(e, "title")
+		if title == "" {
+			title = id
+		}
+		description := stringAttr(e, "description")
+
+		state.Roles = append(state.Roles, GCPRole{
+			ID:          types.StringValue(id),
+			Title:       types.StringValue(title),
+			Description: types.StringPointerValue(grab.If(description == "", nil, &description)),
+			Custom:      types.BoolValue(custom),
+		})
+		state.IDs = append(state.IDs, types.StringValue(id))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// organizationName returns the resource name of a GCP organization, e.g. organizations/1234.
+// Integrations may store the organization as organizations/1234, organization/1234 or just 1234.
+func organizationName(id string) string {
+	for _, prefix := range []string{"organizations/", "organization/"} {
+		if n, ok := strings.CutPrefix(id, prefix); ok {
+			return "organizations/" + n
+		}
+	}
+	return "organizations/" + id
+}
+
+// stringAttr returns the value of a string attribute of the entity, or an empty string if it isn't set.
+func stringAttr(e *entityv1alpha1.Entity, key string) string {
+	for _, a := range e.Attributes {
+		if a.Key == key {
+			return a.Value.GetStr()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,72 @@
+package gcp_test
+
+import (
+	"fmt"
+	"testing"
+
+	entityv1alpha1 "github.com/common-fate/sdk/gen/commonfate/entity/v1alpha1"
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func role(id, title string) *entityv1alpha1.Entity {
+	return &entityv1alpha1.Entity{
+		Eid: &entityv1alpha1.EID{Type: "GCP::Role", Id: id},
+		Attributes: []*entityv1alpha1.Attribute{
+			{Key: "title", Value: &entityv1alpha1.Value{Value: &entityv1alpha1.Value_Str{Str: title}}},
+		},
+	}
+}
+
+func TestAccGCPRolesDatasource(t *testing.T) {
+	// custom roles are found whichever form the integration's organization ID is written in.
+	for _, orgID := range []string{"organizations/1234", "organization/1234", "1234"} {
+		orgID := orgID
+		t.Run(orgID, func(t *testing.T) {
+			s := acctest.NewServer(t)
+
+			s.PutEntity(role("roles/viewer", "Viewer"))
+			s.PutEntity(role("roles/bigquery.dataViewer", "BigQuery Data Viewer"))
+			s.PutEntity(role("roles/bigquery.admin", "BigQuery Admin"))
+			s.PutEntity(role("organizations/1234/roles/breakglass", "Break Glass"))
+			// defined in a different organization.
+			s.PutEntity(role("organizations/5678/roles/other", "Other"))
+
+			resource.ParallelTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: acctest.ProviderConfig(s) + fmt.Sprintf(`
+resource "commonfate_gcp_integration" "test" {
+  name                                           = "test"
+  organization_id                                = %q
+  google_workspace_customer_id                   = "C123"
+  reader_service_account_credentials_secret_path = "/reader"
+}
+
+data "commonfate_gcp_roles" "all" {
+  gcp_integration_id = commonfate_gcp_integration.test.id
+}
+
+data "commonfate_gcp_roles" "bigquery" {
+  gcp_integration_id = commonfate_gcp_integration.test.id
+  prefix             = "roles/bigquery."
+}
+`, orgID),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("data.commonfate_gcp_roles.all", "roles.#", "4"),
+							resource.TestCheckResourceAttr("data.commonfate_gcp_roles.all", "roles.0.id", "organizations/1234/roles/breakglass"),
+							resource.TestCheckResourceAttr("data.commonfate_gcp_roles.all", "roles.0.title", "Break Glass"),
+							resource.TestCheckResourceAttr("data.commonfate_gcp_roles.all", "roles.0.custom", "true"),
+							resource.TestCheckResourceAttr("data.commonfate_gcp_roles.all", "roles.3.id", "roles/viewer"),
+							resource.TestCheckResourceAttr("data.commonfate_gcp_roles.all", "roles.3.custom", "false"),
+							resource.TestCheckResourceAttr("data.commonfate_gcp_roles.bigquery", "ids.#", "2"),
+							resource.TestCheckResourceAttr("data.commonfate_gcp_roles.bigquery", "ids.0", "roles/bigquery.admin"),
+							resource.TestCheckResourceAttr("data.commonfate_gcp_roles.bigquery", "ids.1", "roles/bigquery.dataViewer"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
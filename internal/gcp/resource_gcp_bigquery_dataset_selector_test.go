@@ -0,0 +1,42 @@
+package gcp_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccGCPBigQueryDatasetSelector(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_gcp_bigquery_dataset_selector.test",
+			Config: `
+resource "commonfate_gcp_bigquery_dataset_selector" "test" {
+  id                  = "test"
+  name                = "Test"
+  gcp_organization_id = "organizations/1234"
+  when                = "resource.name like \"*analytics*\""
+}
+`,
+			Check: map[string]string{
+				"id":                  "test",
+				"name":                "Test",
+				"gcp_organization_id": "organizations/1234",
+				"when":                "resource.name like \"*analytics*\"",
+			},
+			UpdatedConfig: `
+resource "commonfate_gcp_bigquery_dataset_selector" "test" {
+  id                  = "test"
+  name                = "Test Updated"
+  gcp_organization_id = "organizations/1234"
+  when                = "true"
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name": "Test Updated",
+				"when": "true",
+			},
+		},
+	})
+}
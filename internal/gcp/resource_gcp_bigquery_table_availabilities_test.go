@@ -0,0 +1,43 @@
+package gcp_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccGCPBigQueryTableAvailabilities(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_gcp_bigquery_table_availabilities.test",
+			Config: `
+resource "commonfate_gcp_bigquery_table_availabilities" "test" {
+  workflow_id                    = "aw_123"
+  gcp_role                       = "roles/bigquery.dataViewer"
+  gcp_bigquery_table_selector_id = "sel_123"
+  google_workspace_customer_id   = "C123"
+}
+`,
+			Check: map[string]string{
+				"workflow_id":                    "aw_123",
+				"gcp_role":                       "roles/bigquery.dataViewer",
+				"gcp_bigquery_table_selector_id": "sel_123",
+				"google_workspace_customer_id":   "C123",
+			},
+			UpdatedConfig: `
+resource "commonfate_gcp_bigquery_table_availabilities" "test" {
+  workflow_id                    = "aw_456"
+  gcp_role                       = "roles/bigquery.dataViewer"
+  gcp_bigquery_table_selector_id = "sel_123"
+  google_workspace_customer_id   = "C123"
+  role_priority                  = 10
+}
+`,
+			UpdatedCheck: map[string]string{
+				"workflow_id":   "aw_456",
+				"role_priority": "10",
+			},
+		},
+	})
+}
@@ -3,14 +3,16 @@ package gcp
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/common-fate/grab"
-	config_client "github.com/common-fate/sdk/config"
 	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
 	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
 	"github.com/common-fate/sdk/service/control/integration"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
 	"github.com/common-fate/terraform-provider-commonfate/pkg/diags"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,18 +22,21 @@ import (
 )
 
 type GCPIntegrationModel struct {
-	Id                                             types.String `tfsdk:"id"`
-	Name                                           types.String `tfsdk:"name"`
-	ReaderWorkloadIdentityConfig                   types.String `tfsdk:"reader_workload_identity_config"`
-	ReaderServiceAccountCredentialsSecretPath      types.String `tfsdk:"reader_service_account_credentials_secret_path"`
-	OrganizationID                                 types.String `tfsdk:"organization_id"`
-	GoogleWorkspaceCustomerID                      types.String `tfsdk:"google_workspace_customer_id"`
-	ProvisionerWorkloadIdentityConfig              types.String `tfsdk:"provisioner_workload_identity_config"`
-	ProvisionerServiceAccountCredentialsSecretPath types.String `tfsdk:"provisioner_service_account_credentials_secret_path"`
+	Id                                             types.String   `tfsdk:"id"`
+	Name                                           types.String   `tfsdk:"name"`
+	ReaderWorkloadIdentityConfig                   types.String   `tfsdk:"reader_workload_identity_config"`
+	ReaderServiceAccountCredentialsSecretPath      types.String   `tfsdk:"reader_service_account_credentials_secret_path"`
+	OrganizationID                                 types.String   `tfsdk:"organization_id"`
+	GoogleWorkspaceCustomerID                      types.String   `tfsdk:"google_workspace_customer_id"`
+	ProvisionerWorkloadIdentityConfig              types.String   `tfsdk:"provisioner_workload_identity_config"`
+	ProvisionerServiceAccountCredentialsSecretPath types.String   `tfsdk:"provisioner_service_account_credentials_secret_path"`
+	Timeouts                                       timeouts.Value `tfsdk:"timeouts"`
 }
 
 type GCPIntegrationResource struct {
-	client integrationv1alpha1connect.IntegrationServiceClient
+	client         integrationv1alpha1connect.IntegrationServiceClient
+	defaultTimeout time.Duration
+	deployment     providerdata.Deployment
 }
 
 var (
@@ -51,15 +56,18 @@ func (r *GCPIntegrationResource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
-	cfg, ok := req.ProviderData.(*config_client.Context)
+	providerData, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
+	cfg := providerData.Config
+	r.defaultTimeout = providerData.DefaultTimeout
+	r.deployment = providerData.Deployment
 	client := integration.NewFromConfig(cfg)
 
 	r.client = client
@@ -109,6 +117,14 @@ func (r *GCPIntegrationResource) Schema(ctx context.Context, req resource.Schema
 			},
 		},
 		MarkdownDescription: `Registers an integration with Google Cloud`,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -136,6 +152,15 @@ func (r *GCPIntegrationResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	res, err := r.client.CreateIntegration(ctx, connect.NewRequest(&integrationv1alpha1.CreateIntegrationRequest{
 		Name: data.Name.ValueString(),
 		Config: &integrationv1alpha1.Config{
@@ -168,6 +193,7 @@ func (r *GCPIntegrationResource) Create(ctx context.Context, req resource.Create
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -184,6 +210,23 @@ func (r *GCPIntegrationResource) Read(ctx context.Context, req resource.ReadRequ
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	//read the state from the client
 	res, err := r.client.GetIntegration(ctx, connect.NewRequest(&integrationv1alpha1.GetIntegrationRequest{
@@ -211,6 +254,7 @@ func (r *GCPIntegrationResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	state = GCPIntegrationModel{
+		Timeouts:                     state.Timeouts,
 		Id:                           types.StringValue(state.Id.ValueString()),
 		Name:                         types.StringValue(res.Msg.Integration.Name),
 		ReaderWorkloadIdentityConfig: grab.If(integ.ReaderWorkloadIdentityConfig == "", types.StringNull(), types.StringValue(integ.ReaderWorkloadIdentityConfig)),
@@ -222,6 +266,7 @@ func (r *GCPIntegrationResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *GCPIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -244,6 +289,20 @@ func (r *GCPIntegrationResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	res, err := r.client.UpdateIntegration(ctx, connect.NewRequest(&integrationv1alpha1.UpdateIntegrationRequest{
 		Integration: &integrationv1alpha1.Integration{
 			Id:   data.Id.ValueString(),
@@ -279,6 +338,7 @@ func (r *GCPIntegrationResource) Update(ctx context.Context, req resource.Update
 	data.Id = types.StringValue(res.Msg.Integration.Id)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *GCPIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -301,6 +361,20 @@ func (r *GCPIntegrationResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.DeleteIntegration(ctx, connect.NewRequest(&integrationv1alpha1.DeleteIntegrationRequest{
 		Id: data.Id.ValueString(),
 	}))
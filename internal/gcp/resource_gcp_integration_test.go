@@ -0,0 +1,43 @@
+package gcp_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccGCPIntegration(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_gcp_integration.test",
+			Config: `
+resource "commonfate_gcp_integration" "test" {
+  name                                           = "test"
+  organization_id                                = "organizations/1234"
+  google_workspace_customer_id                   = "C123"
+  reader_service_account_credentials_secret_path = "/reader"
+}
+`,
+			Check: map[string]string{
+				"name":                         "test",
+				"organization_id":              "organizations/1234",
+				"google_workspace_customer_id": "C123",
+				"reader_service_account_credentials_secret_path": "/reader",
+			},
+			UpdatedConfig: `
+resource "commonfate_gcp_integration" "test" {
+  name                                                = "test-updated"
+  organization_id                                     = "organizations/1234"
+  google_workspace_customer_id                        = "C123"
+  reader_service_account_credentials_secret_path      = "/reader"
+  provisioner_service_account_credentials_secret_path = "/provisioner"
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name": "test-updated",
+				"provisioner_service_account_credentials_secret_path": "/provisioner",
+			},
+		},
+	})
+}
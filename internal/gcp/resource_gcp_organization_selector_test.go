@@ -0,0 +1,38 @@
+package gcp_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccGCPOrganizationSelector(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_gcp_organization_selector.test",
+			Config: `
+resource "commonfate_gcp_organization_selector" "test" {
+  id                  = "test"
+  name                = "Test"
+  gcp_organization_id = "organizations/1234"
+}
+`,
+			Check: map[string]string{
+				"id":                  "test",
+				"name":                "Test",
+				"gcp_organization_id": "organizations/1234",
+			},
+			UpdatedConfig: `
+resource "commonfate_gcp_organization_selector" "test" {
+  id                  = "test"
+  name                = "Test Updated"
+  gcp_organization_id = "organizations/1234"
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name": "Test Updated",
+			},
+		},
+	})
+}
@@ -3,12 +3,14 @@ package gcp
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
-	config_client "github.com/common-fate/sdk/config"
 	configv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1"
 	configv1alpha1connect "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1/configv1alpha1connect"
 	"github.com/common-fate/sdk/service/control/config/gcprolegroup"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,14 +20,17 @@ import (
 )
 
 type GCPRoleGroupModel struct {
-	ID      types.String   `tfsdk:"id"`
-	RoleIDs []types.String `tfsdk:"role_ids"`
-	Name    types.String   `tfsdk:"name"`
-	OrgID   types.String   `tfsdk:"gcp_organization_id"`
+	ID       types.String   `tfsdk:"id"`
+	RoleIDs  []types.String `tfsdk:"role_ids"`
+	Name     types.String   `tfsdk:"name"`
+	OrgID    types.String   `tfsdk:"gcp_organization_id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type GCPRoleGroupResource struct {
-	client configv1alpha1connect.GCPRoleGroupServiceClient
+	client         configv1alpha1connect.GCPRoleGroupServiceClient
+	defaultTimeout time.Duration
+	deployment     providerdata.Deployment
 }
 
 var (
@@ -45,15 +50,18 @@ func (r *GCPRoleGroupResource) Configure(_ context.Context, req resource.Configu
 		return
 	}
 
-	cfg, ok := req.ProviderData.(*config_client.Context)
+	providerData, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
+	cfg := providerData.Config
+	r.defaultTimeout = providerData.DefaultTimeout
+	r.deployment = providerData.Deployment
 
 	r.client = gcprolegroup.NewFromConfig(cfg)
 }
@@ -87,6 +95,14 @@ func (r *GCPRoleGroupResource) Schema(ctx context.Context, req resource.SchemaRe
 			},
 		},
 		MarkdownDescription: `Defines GCP role group resource`,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -114,6 +130,15 @@ func (r *GCPRoleGroupResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	createGCPRoleGroup := &configv1alpha1.CreateGCPRoleGroupRequest{
 		Name:           data.Name.ValueString(),
 		OrganizationId: data.OrgID.ValueString(),
@@ -142,6 +167,7 @@ func (r *GCPRoleGroupResource) Create(ctx context.Context, req resource.CreateRe
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -158,6 +184,23 @@ func (r *GCPRoleGroupResource) Read(ctx context.Context, req resource.ReadReques
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	//read the state from the client
 	res, err := r.client.GetGCPRoleGroup(ctx, connect.NewRequest(&configv1alpha1.GetGCPRoleGroupRequest{
@@ -176,9 +219,10 @@ func (r *GCPRoleGroupResource) Read(ctx context.Context, req resource.ReadReques
 
 	// refresh state
 	state = GCPRoleGroupModel{
-		ID:    types.StringValue(res.Msg.RoleGroup.Id),
-		Name:  types.StringValue(res.Msg.RoleGroup.Name),
-		OrgID: types.StringValue(res.Msg.RoleGroup.OrganizationId),
+		Timeouts: state.Timeouts,
+		ID:       types.StringValue(res.Msg.RoleGroup.Id),
+		Name:     types.StringValue(res.Msg.RoleGroup.Name),
+		OrgID:    types.StringValue(res.Msg.RoleGroup.OrganizationId),
 	}
 
 	for _, r := range res.Msg.RoleGroup.RoleIds {
@@ -186,6 +230,7 @@ func (r *GCPRoleGroupResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *GCPRoleGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -208,6 +253,20 @@ func (r *GCPRoleGroupResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updateGCPRoleGroup := &configv1alpha1.UpdateGCPRoleGroupRequest{
 		RoleGroup: &configv1alpha1.GCPRoleGroup{
 			Id:             data.ID.ValueString(),
@@ -235,6 +294,7 @@ func (r *GCPRoleGroupResource) Update(ctx context.Context, req resource.UpdateRe
 	data.ID = types.StringValue(res.Msg.RoleGroup.Id)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *GCPRoleGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -252,6 +312,20 @@ func (r *GCPRoleGroupResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.DeleteGCPRoleGroup(ctx, connect.NewRequest(&configv1alpha1.DeleteGCPRoleGroupRequest{
 		Id: data.ID.ValueString(),
 	}))
@@ -0,0 +1,39 @@
+package gcp_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccGCPRoleGroup(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_gcp_role_group.test",
+			Config: `
+resource "commonfate_gcp_role_group" "test" {
+  name                = "Test"
+  gcp_organization_id = "organizations/1234"
+  role_ids            = ["roles/viewer"]
+}
+`,
+			Check: map[string]string{
+				"name":                "Test",
+				"gcp_organization_id": "organizations/1234",
+				"role_ids.#":          "1",
+			},
+			UpdatedConfig: `
+resource "commonfate_gcp_role_group" "test" {
+  name                = "Test Updated"
+  gcp_organization_id = "organizations/1234"
+  role_ids            = ["roles/viewer", "roles/browser"]
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name":       "Test Updated",
+				"role_ids.#": "2",
+			},
+		},
+	})
+}
@@ -0,0 +1,278 @@
+package generic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	sdkeid "github.com/common-fate/sdk/eid"
+	entityv1alpha1 "github.com/common-fate/sdk/gen/commonfate/entity/v1alpha1"
+	"github.com/common-fate/sdk/service/entity"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/common-fate/terraform-provider-commonfate/pkg/eid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type EntitiesDatasourceModel struct {
+	Type        types.String  `tfsdk:"type"`
+	BelongingTo *eid.EID      `tfsdk:"belonging_to"`
+	Attributes  types.Map     `tfsdk:"attributes"`
+	Entities    []EntityModel `tfsdk:"entities"`
+}
+
+type EntityModel struct {
+	EID        eid.EID      `tfsdk:"eid"`
+	Name       types.String `tfsdk:"name"`
+	Attributes types.Map    `tfsdk:"attributes"`
+}
+
+// EntitiesDatasource queries the entities which have been synced by integrations.
+type EntitiesDatasource struct {
+	client entity.Client
+}
+
+var _ datasource.DataSource = &EntitiesDatasource{}
+
+// Metadata returns the data source type name.
+func (r *EntitiesDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entities"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *EntitiesDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	cfg := providerData.Config
+
+	r.client = entity.NewFromConfig(cfg)
+}
+
+func (r *EntitiesDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Queries the entities which have been synced by your integrations, such as AWS accounts, permission sets or GCP roles. Use it to look up the EIDs which availability and selector resources refer to.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The entity type to query, e.g. `AWS::IDC::PermissionSet`.",
+				Required:            true,
+			},
+			"belonging_to": schema.SingleNestedAttribute{
+				MarkdownDescription: "If set, only entities which are descendants of this entity are returned.",
+				Optional:            true,
+				Attributes:          eid.DataSourceEIDAttrs,
+			},
+			"attributes": schema.MapAttribute{
+				MarkdownDescription: "If set, only entities with these attribute values are returned. Values are compared with the entity's attributes as they are shown in `entities`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"entities": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching entities, ordered by ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"eid": schema.SingleNestedAttribute{
+							MarkdownDescription: "The EID of the entity.",
+							Computed:            true,
+							Attributes:          eid.ComputedDataSourceEIDAttrs,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the entity, or its ID if it doesn't have a name.",
+							Computed:            true,
+						},
+						"attributes": schema.MapAttribute{
+							MarkdownDescription: "The attributes of the entity. Entity references are shown in Cedar syntax, and sets and records as JSON.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *EntitiesDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// the entity client is a struct rather than an interface, so it's unconfigured if it has no underlying client.
+	if r.client.RawClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HTTP Client",
+			"Expected configured HTTP client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var state EntitiesDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := map[string]string{}
+	if !state.Attributes.IsNull() {
+		resp.Diagnostics.Append(state.Attributes.ElementsAs(ctx, &filters, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	all, err := r.client.All(ctx, entity.ListInput{Type: state.Type.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list Entities",
+			err.Error(),
+		)
+		return
+	}
+
+	var descendants map[sdkeid.EID]bool
+	if state.BelongingTo != nil {
+		descendants, err = r.descendantsOf(ctx, sdkeid.FromAPI(state.BelongingTo.ToAPI()))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("belonging_to"),
+				"Failed to list descendants of "+state.BelongingTo.String(),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Eid.Id < all[j].Eid.Id
+	})
+
+	state.Entities = []EntityModel{}
+	for _, e := range all {
+		if descendants != nil && !descendants[sdkeid.FromAPI(e.Eid)] {
+			continue
+		}
+
+		attrs := map[string]string{}
+		for _, a := range e.Attributes {
+			attrs[a.Key] = attributeString(a.Value)
+		}
+
+		if !matchesFilters(attrs, filters) {
+			continue
+		}
+
+		name, ok := attrs["name"]
+		if !ok || name == "" {
+			name = e.Eid.Id
+		}
+
+		attrValues, diags := types.MapValueFrom(ctx, types.StringType, attrs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Entities = append(state.Entities, EntityModel{
+			EID:        eid.EIDFromAPI(e.Eid),
+			Name:       types.StringValue(name),
+			Attributes: attrValues,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// descendantsOf returns every entity below parent in the entity graph.
+func (r *EntitiesDatasource) descendantsOf(ctx context.Context, parent sdkeid.EID) (map[sdkeid.EID]bool, error) {
+	seen := map[sdkeid.EID]bool{}
+	queue := []sdkeid.EID{parent}
+
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+
+		err := r.client.ListChildrenRequest(entity.ListChildrenInput{Parent: next}).Pages(ctx, func(out entity.ListChildrenOutput) error {
+			for _, child := range out.Children {
+				if !seen[child] {
+					seen[child] = true
+					queue = append(queue, child)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return seen, nil
+}
+
+func matchesFilters(attrs map[string]string, filters map[string]string) bool {
+	for k, want := range filters {
+		if got, ok := attrs[k]; !ok || got != want {
+			return false
+		}
+	}
+	return true
+}
+
+// attributeString formats an entity attribute value as a string.
+// Entity references are formatted in Cedar syntax, and sets and records as JSON.
+func attributeString(v *entityv1alpha1.Value) string {
+	switch v := v.GetValue().(type) {
+	case *entityv1alpha1.Value_Str:
+		return v.Str
+	case *entityv1alpha1.Value_Bool:
+		return strconv.FormatBool(v.Bool)
+	case *entityv1alpha1.Value_Long:
+		return strconv.FormatInt(v.Long, 10)
+	case *entityv1alpha1.Value_Entity:
+		return eid.EIDFromAPI(v.Entity).String()
+	}
+
+	b, err := json.Marshal(attributeJSON(v))
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func attributeJSON(v *entityv1alpha1.Value) any {
+	switch v := v.GetValue().(type) {
+	case *entityv1alpha1.Value_Str:
+		return v.Str
+	case *entityv1alpha1.Value_Bool:
+		return v.Bool
+	case *entityv1alpha1.Value_Long:
+		return v.Long
+	case *entityv1alpha1.Value_Entity:
+		return eid.EIDFromAPI(v.Entity).String()
+	case *entityv1alpha1.Value_Record:
+		out := map[string]any{}
+		for _, a := range v.Record.Attributes {
+			out[a.Key] = attributeJSON(a.Value)
+		}
+		return out
+	case *entityv1alpha1.Value_Set:
+		out := []any{}
+		for _, item := range v.Set.Values {
+			out = append(out, attributeJSON(item))
+		}
+		return out
+	}
+	return nil
+}
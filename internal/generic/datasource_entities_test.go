@@ -0,0 +1,116 @@
+package generic_test
+
+import (
+	"testing"
+
+	entityv1alpha1 "github.com/common-fate/sdk/gen/commonfate/entity/v1alpha1"
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+	"github.com/common-fate/terraform-provider-commonfate/internal/mockapi"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func str(s string) *entityv1alpha1.Value {
+	return &entityv1alpha1.Value{Value: &entityv1alpha1.Value_Str{Str: s}}
+}
+
+func putEntities(s *mockapi.Server) {
+	org := &entityv1alpha1.EID{Type: "AWS::Organization", Id: "o-1"}
+	ou := &entityv1alpha1.EID{Type: "AWS::OrgUnit", Id: "ou-prod"}
+	instance := &entityv1alpha1.EID{Type: "AWS::IDC::Instance", Id: "ssoins-1"}
+
+	s.PutEntity(&entityv1alpha1.Entity{Eid: org})
+	s.PutEntity(&entityv1alpha1.Entity{Eid: ou}, org)
+	s.PutEntity(&entityv1alpha1.Entity{Eid: instance})
+
+	s.PutEntity(&entityv1alpha1.Entity{
+		Eid: &entityv1alpha1.EID{Type: "AWS::Account", Id: "111111111111"},
+		Attributes: []*entityv1alpha1.Attribute{
+			{Key: "name", Value: str("prod")},
+			{Key: "ou", Value: &entityv1alpha1.Value{Value: &entityv1alpha1.Value_Entity{Entity: ou}}},
+		},
+	}, ou)
+	s.PutEntity(&entityv1alpha1.Entity{
+		Eid: &entityv1alpha1.EID{Type: "AWS::Account", Id: "222222222222"},
+		Attributes: []*entityv1alpha1.Attribute{
+			{Key: "name", Value: str("dev")},
+		},
+	}, org)
+	s.PutEntity(&entityv1alpha1.Entity{
+		Eid: &entityv1alpha1.EID{Type: "AWS::Account", Id: "333333333333"},
+	})
+
+	s.PutEntity(&entityv1alpha1.Entity{
+		Eid: &entityv1alpha1.EID{Type: "AWS::IDC::PermissionSet", Id: "arn:aws:sso:::permissionSet/ssoins-1/ps-admin"},
+		Attributes: []*entityv1alpha1.Attribute{
+			{Key: "name", Value: str("AdministratorAccess")},
+			{Key: "managed", Value: &entityv1alpha1.Value{Value: &entityv1alpha1.Value_Bool{Bool: true}}},
+			{Key: "tags", Value: &entityv1alpha1.Value{Value: &entityv1alpha1.Value_Set{Set: &entityv1alpha1.Set{Values: []*entityv1alpha1.Value{str("a"), str("b")}}}}},
+		},
+	}, instance)
+	s.PutEntity(&entityv1alpha1.Entity{
+		Eid: &entityv1alpha1.EID{Type: "AWS::IDC::PermissionSet", Id: "arn:aws:sso:::permissionSet/ssoins-1/ps-readonly"},
+		Attributes: []*entityv1alpha1.Attribute{
+			{Key: "name", Value: str("ReadOnlyAccess")},
+			{Key: "managed", Value: &entityv1alpha1.Value{Value: &entityv1alpha1.Value_Bool{Bool: false}}},
+		},
+	}, instance)
+}
+
+func TestAccEntitiesDatasource(t *testing.T) {
+	s := acctest.NewServer(t)
+	putEntities(s)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(s) + `
+data "commonfate_entities" "accounts" {
+  type = "AWS::Account"
+}
+
+data "commonfate_entities" "org_accounts" {
+  type = "AWS::Account"
+
+  belonging_to = {
+    type = "AWS::Organization"
+    id   = "o-1"
+  }
+}
+
+data "commonfate_entities" "managed_permission_sets" {
+  type = "AWS::IDC::PermissionSet"
+
+  attributes = {
+    managed = "true"
+  }
+}
+
+data "commonfate_entities" "missing" {
+  type = "AWS::Account"
+
+  attributes = {
+    name = "staging"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.commonfate_entities.accounts", "entities.#", "3"),
+					resource.TestCheckResourceAttr("data.commonfate_entities.accounts", "entities.0.eid.type", "AWS::Account"),
+					resource.TestCheckResourceAttr("data.commonfate_entities.accounts", "entities.0.eid.id", "111111111111"),
+					resource.TestCheckResourceAttr("data.commonfate_entities.accounts", "entities.0.name", "prod"),
+					resource.TestCheckResourceAttr("data.commonfate_entities.accounts", "entities.0.attributes.ou", `AWS::OrgUnit::"ou-prod"`),
+					resource.TestCheckResourceAttr("data.commonfate_entities.accounts", "entities.2.name", "333333333333"),
+					resource.TestCheckResourceAttr("data.commonfate_entities.accounts", "entities.2.attributes.%", "0"),
+					resource.TestCheckResourceAttr("data.commonfate_entities.org_accounts", "entities.#", "2"),
+					resource.TestCheckResourceAttr("data.commonfate_entities.org_accounts", "entities.0.name", "prod"),
+					resource.TestCheckResourceAttr("data.commonfate_entities.org_accounts", "entities.1.name", "dev"),
+					resource.TestCheckResourceAttr("data.commonfate_entities.managed_permission_sets", "entities.#", "1"),
+					resource.TestCheckResourceAttr("data.commonfate_entities.managed_permission_sets", "entities.0.name", "AdministratorAccess"),
+					resource.TestCheckResourceAttr("data.commonfate_entities.managed_permission_sets", "entities.0.attributes.tags", `["a","b"]`),
+					resource.TestCheckResourceAttr("data.commonfate_entities.missing", "entities.#", "0"),
+				),
+			},
+		},
+	})
+}
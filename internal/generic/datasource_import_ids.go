@@ -0,0 +1,377 @@
+package generic
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"connectrpc.com/connect"
+	configv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1"
+	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
+	"github.com/common-fate/sdk/service/authz/policyset"
+	"github.com/common-fate/sdk/service/control/configsvc"
+	"github.com/common-fate/sdk/service/control/integration"
+	"github.com/common-fate/sdk/service/entity"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// selectorResourceTypes maps the typed selector resources to the entity type their
+// selectors match, so that the selectors of each resource can be told apart.
+var selectorResourceTypes = map[string]string{
+	"commonfate_auth0_organization_selector":    "Auth0::Organization",
+	"commonfate_aws_account_selector":           "AWS::Account",
+	"commonfate_aws_eks_selector":               "AWS::EKS::Cluster",
+	"commonfate_aws_idc_group_selector":         "AWS::IDC::Group",
+	"commonfate_aws_rds_database_selector":      "AWS::RDS::Database",
+	"commonfate_datastax_organization_selector": "DataStax::Organization",
+	"commonfate_entra_group_selector":           "Entra::Group",
+	"commonfate_gcp_bigquery_dataset_selector":  "GCP::BigQuery::Dataset",
+	"commonfate_gcp_bigquery_table_selector":    "GCP::BigQuery::Table",
+	"commonfate_gcp_folder_selector":            "GCP::Folder",
+	"commonfate_gcp_organization_selector":      "GCP::Organization",
+	"commonfate_gcp_project_selector":           "GCP::Project",
+	"commonfate_okta_group_selector":            "Okta::Group",
+	"commonfate_snowflake_database_selector":    "Snowflake::Database",
+}
+
+// integrationResourceTypes maps the integration resources to a check for whether an
+// integration's config is of the kind the resource manages.
+var integrationResourceTypes = map[string]func(*integrationv1alpha1.Config) bool{
+	"commonfate_auth0_integration":     func(c *integrationv1alpha1.Config) bool { return c.GetAuth0() != nil },
+	"commonfate_aws_idc_integration":   func(c *integrationv1alpha1.Config) bool { return c.GetAwsIdc() != nil },
+	"commonfate_datastax_integration":  func(c *integrationv1alpha1.Config) bool { return c.GetDatastax() != nil },
+	"commonfate_entra_integration":     func(c *integrationv1alpha1.Config) bool { return c.GetEntra() != nil },
+	"commonfate_gcp_integration":       func(c *integrationv1alpha1.Config) bool { return c.GetGcp() != nil },
+	"commonfate_jira_integration":      func(c *integrationv1alpha1.Config) bool { return c.GetJira() != nil },
+	"commonfate_okta_integration":      func(c *integrationv1alpha1.Config) bool { return c.GetOkta() != nil },
+	"commonfate_opsgenie_integration":  func(c *integrationv1alpha1.Config) bool { return c.GetOpsgenie() != nil },
+	"commonfate_pagerduty_integration": func(c *integrationv1alpha1.Config) bool { return c.GetPagerduty() != nil },
+	"commonfate_s3_log_destination":    func(c *integrationv1alpha1.Config) bool { return c.GetS3LogDestination() != nil },
+	"commonfate_slack_integration":     func(c *integrationv1alpha1.Config) bool { return c.GetSlack() != nil },
+	"commonfate_snowflake_integration": func(c *integrationv1alpha1.Config) bool { return c.GetSnowflake() != nil },
+	"commonfate_webhook_integration":   func(c *integrationv1alpha1.Config) bool { return c.GetWebhook() != nil },
+}
+
+// availabilityKind describes the availability specs which a typed availability resource manages.
+type availabilityKind struct {
+	// roleType is the entity type of the spec's role.
+	roleType string
+	// targetType is the entity type of the spec's target.
+	targetType string
+	// selectorType is the resource type of the selector the spec targets,
+	// for availabilities which target a selector rather than a single resource.
+	selectorType string
+}
+
+// availabilityResourceTypes maps the typed availability resources to the specs they manage.
+// Several resources share a role and target type, so the selector which is targeted tells them apart.
+var availabilityResourceTypes = map[string]availabilityKind{
+	"commonfate_auth0_organization_availabilities":     {roleType: "Auth0::Role", targetType: "Access::Selector", selectorType: "Auth0::Organization"},
+	"commonfate_aws_eks_availability":                  {roleType: "AWS::EKS::ServiceAccount", targetType: "AWS::EKS::Cluster"},
+	"commonfate_aws_idc_account_availabilities":        {roleType: "AWS::IDC::PermissionSet", targetType: "Access::Selector", selectorType: "AWS::Account"},
+	"commonfate_aws_idc_group_availabilities":          {roleType: "AWS::IDC::GroupRole", targetType: "Access::Selector", selectorType: "AWS::IDC::Group"},
+	"commonfate_aws_rds_database_availabilities":       {roleType: "AWS::RDS::DatabaseUser", targetType: "Access::Selector", selectorType: "AWS::RDS::Database"},
+	"commonfate_aws_rds_database_availability":         {roleType: "AWS::RDS::DatabaseUser", targetType: "AWS::RDS::Database"},
+	"commonfate_datastax_organization_availabilities":  {roleType: "DataStax::Role", targetType: "Access::Selector", selectorType: "DataStax::Organization"},
+	"commonfate_eks_availabilities":                    {roleType: "AWS::EKS::ServiceAccount", targetType: "AWS::Selector", selectorType: "AWS::EKS::Cluster"},
+	"commonfate_entra_group_availabilities":            {roleType: "Entra::GroupRole", targetType: "Access::Selector", selectorType: "Entra::Group"},
+	"commonfate_gcp_bigquery_dataset_availabilities":   {roleType: "GCP::Role", targetType: "Access::Selector", selectorType: "GCP::BigQuery::Dataset"},
+	"commonfate_gcp_bigquery_table_availabilities":     {roleType: "GCP::Role", targetType: "Access::Selector", selectorType: "GCP::BigQuery::Table"},
+	"commonfate_gcp_folder_availabilities":             {roleType: "GCP::Role", targetType: "Access::Selector", selectorType: "GCP::Folder"},
+	"commonfate_gcp_organization_availabilities":       {roleType: "GCP::Role", targetType: "Access::Selector", selectorType: "GCP::Organization"},
+	"commonfate_gcp_project_availabilities":            {roleType: "GCP::Role", targetType: "Access::Selector", selectorType: "GCP::Project"},
+	"commonfate_gcp_role_group_folder_availabilities":  {roleType: "GCP::RoleGroup", targetType: "Access::Selector", selectorType: "GCP::Folder"},
+	"commonfate_gcp_role_group_project_availabilities": {roleType: "GCP::RoleGroup", targetType: "Access::Selector", selectorType: "GCP::Project"},
+	"commonfate_okta_group_availabilities":             {roleType: "Okta::GroupRole", targetType: "Access::Selector", selectorType: "Okta::Group"},
+	"commonfate_snowflake_account_availability":        {roleType: "Snowflake::AccountRole", targetType: "Snowflake::Account"},
+	"commonfate_snowflake_database_availabilities":     {roleType: "Snowflake::DatabaseRole", targetType: "Access::Selector", selectorType: "Snowflake::Database"},
+}
+
+const (
+	accessWorkflowResourceType   = "commonfate_access_workflow"
+	availabilitySpecResourceType = "commonfate_availability_spec"
+	policySetResourceType        = "commonfate_policyset"
+	selectorResourceType         = "commonfate_selector"
+)
+
+// The config API can't list access workflows or availability specs,
+// so they are listed through the entities they are exposed as instead.
+const (
+	workflowEntityType     = "Access::Workflow"
+	availabilityEntityType = "Access::Availability"
+)
+
+type ImportIDsDatasourceModel struct {
+	ResourceType types.String   `tfsdk:"resource_type"`
+	IDs          []types.String `tfsdk:"ids"`
+}
+
+// ImportIDsDatasource lists the IDs which can be imported into a resource type,
+// so that existing configuration can be brought under management in bulk.
+type ImportIDsDatasource struct {
+	config      *configsvc.Client
+	entity      entity.Client
+	integration integrationv1alpha1connect.IntegrationServiceClient
+	policySet   policyset.Client
+}
+
+var _ datasource.DataSource = &ImportIDsDatasource{}
+
+// Metadata returns the data source type name.
+func (r *ImportIDsDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_import_ids"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *ImportIDsDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	cfg := providerData.Config
+
+	r.config = configsvc.NewFromConfig(cfg)
+	r.entity = entity.NewFromConfig(cfg)
+	r.integration = integration.NewFromConfig(cfg)
+	r.policySet = policyset.NewFromConfig(cfg)
+}
+
+func importableResourceTypes() []string {
+	out := []string{accessWorkflowResourceType, availabilitySpecResourceType, policySetResourceType, selectorResourceType}
+	for k := range selectorResourceTypes {
+		out = append(out, k)
+	}
+	for k := range integrationResourceTypes {
+		out = append(out, k)
+	}
+	for k := range availabilityResourceTypes {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func (r *ImportIDsDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the IDs of the existing objects which can be imported into a resource type. " +
+			"Use the IDs in `import` blocks and run `terraform plan -generate-config-out` to generate configuration for every existing object at once. " +
+			"ECS proxies and the resources registered with them, AWS resource scanners, webhook provisioners, Slack alerts and GCP role groups " +
+			"have no list operation in the API, so their IDs need to be looked up in the Common Fate web console.",
+		Attributes: map[string]schema.Attribute{
+			"resource_type": schema.StringAttribute{
+				MarkdownDescription: "The resource type to list IDs for, e.g. `commonfate_access_workflow`. " +
+					"`commonfate_selector` and `commonfate_availability_spec` list every selector and availability, " +
+					"whereas the typed selector, availability and integration resources only list the objects of their kind.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(importableResourceTypes()...),
+				},
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The importable IDs, in sorted order.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ImportIDsDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if r.config == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HTTP Client",
+			"Expected configured HTTP client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var state ImportIDsDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceType := state.ResourceType.ValueString()
+
+	var ids []string
+	var err error
+	switch resourceType {
+	case accessWorkflowResourceType:
+		ids, err = r.entityIDs(ctx, workflowEntityType)
+	case availabilitySpecResourceType:
+		ids, err = r.entityIDs(ctx, availabilityEntityType)
+	case policySetResourceType:
+		ids, err = r.policySetIDs(ctx)
+	case selectorResourceType:
+		ids, err = r.selectorIDs(ctx, "")
+	default:
+		if entityType, ok := selectorResourceTypes[resourceType]; ok {
+			ids, err = r.selectorIDs(ctx, entityType)
+		} else if isKind, ok := integrationResourceTypes[resourceType]; ok {
+			ids, err = r.integrationIDs(ctx, isKind)
+		} else {
+			ids, err = r.availabilityIDs(ctx, availabilityResourceTypes[resourceType])
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list importable IDs for "+resourceType,
+			err.Error(),
+		)
+		return
+	}
+
+	sort.Strings(ids)
+
+	state.IDs = []types.String{}
+	for _, id := range ids {
+		state.IDs = append(state.IDs, types.StringValue(id))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// entityIDs lists the IDs of the entities of the given type. Access workflows and
+// availability specs are exposed as entities, which is the only way to list them.
+func (r *ImportIDsDatasource) entityIDs(ctx context.Context, entityType string) ([]string, error) {
+	all, err := r.entity.All(ctx, entity.ListInput{Type: entityType})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, e := range all {
+		ids = append(ids, e.Eid.Id)
+	}
+	return ids, nil
+}
+
+func (r *ImportIDsDatasource) policySetIDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	err := r.policySet.ListPolicySetsRequest(policyset.ListInput{}).Pages(ctx, func(out policyset.ListOutput) error {
+		for _, ps := range out.PolicySets {
+			ids = append(ids, ps.ID)
+		}
+		return nil
+	})
+	return ids, err
+}
+
+// selectorIDs lists the selectors matching entityType, or every selector if it is empty.
+func (r *ImportIDsDatasource) selectorIDs(ctx context.Context, entityType string) ([]string, error) {
+	var ids []string
+	pageToken := ""
+	for {
+		res, err := r.config.Selector().ListSelectors(ctx, connect.NewRequest(&configv1alpha1.ListSelectorsRequest{
+			PageToken: pageToken,
+		}))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, s := range res.Msg.Selectors {
+			if entityType == "" || s.ResourceType == entityType {
+				ids = append(ids, s.Id)
+			}
+		}
+
+		pageToken = res.Msg.NextPageToken
+		if pageToken == "" {
+			return ids, nil
+		}
+	}
+}
+
+// integrationIDs lists the integrations whose config is of the kind checked by isKind.
+func (r *ImportIDsDatasource) integrationIDs(ctx context.Context, isKind func(*integrationv1alpha1.Config) bool) ([]string, error) {
+	var ids []string
+	pageToken := ""
+	for {
+		res, err := r.integration.ListIntegrations(ctx, connect.NewRequest(&integrationv1alpha1.ListIntegrationsRequest{
+			PageToken: pageToken,
+		}))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, integ := range res.Msg.Integrations {
+			if isKind(integ.Config) {
+				ids = append(ids, integ.Id)
+			}
+		}
+
+		pageToken = res.Msg.NextPageToken
+		if pageToken == "" {
+			return ids, nil
+		}
+	}
+}
+
+// availabilityIDs lists the availability specs of the given kind. The entities only hold
+// the spec IDs, so each spec, and the selector it targets, is fetched to check its kind.
+func (r *ImportIDsDatasource) availabilityIDs(ctx context.Context, kind availabilityKind) ([]string, error) {
+	all, err := r.entityIDs(ctx, availabilityEntityType)
+	if err != nil {
+		return nil, err
+	}
+
+	selectorTypes := map[string]string{}
+	var ids []string
+	for _, id := range all {
+		res, err := r.config.AvailabilitySpec().GetAvailabilitySpec(ctx, connect.NewRequest(&configv1alpha1.GetAvailabilitySpecRequest{
+			Id: id,
+		}))
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			// the availability was deleted after it was listed.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		spec := res.Msg.AvailabilitySpec
+		if spec.GetRole().GetType() != kind.roleType || spec.GetTarget().GetType() != kind.targetType {
+			continue
+		}
+
+		if kind.selectorType != "" {
+			selectorID := spec.GetTarget().GetId()
+			selectorType, ok := selectorTypes[selectorID]
+			if !ok {
+				sel, err := r.config.Selector().GetSelector(ctx, connect.NewRequest(&configv1alpha1.GetSelectorRequest{
+					Id: selectorID,
+				}))
+				if connect.CodeOf(err) == connect.CodeNotFound {
+					// the selector was deleted, so the spec can't be read by a typed resource.
+					continue
+				} else if err != nil {
+					return nil, err
+				}
+				selectorType = sel.Msg.Selector.ResourceType
+				selectorTypes[selectorID] = selectorType
+			}
+			if selectorType != kind.selectorType {
+				continue
+			}
+		}
+
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
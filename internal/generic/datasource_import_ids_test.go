@@ -0,0 +1,185 @@
+package generic_test
+
+import (
+	"regexp"
+	"testing"
+
+	entityv1alpha1 "github.com/common-fate/sdk/gen/commonfate/entity/v1alpha1"
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const importIDsResources = `
+resource "commonfate_aws_account_selector" "prod" {
+  id                  = "sel_prod"
+  name                = "Production"
+  aws_organization_id = "o-123"
+  when                = "true"
+}
+
+resource "commonfate_aws_account_selector" "dev" {
+  id                  = "sel_dev"
+  name                = "Development"
+  aws_organization_id = "o-123"
+  when                = "true"
+}
+
+resource "commonfate_gcp_project_selector" "all" {
+  id                  = "sel_gcp"
+  name                = "All projects"
+  gcp_organization_id = "organizations/123"
+  when                = "true"
+}
+
+resource "commonfate_gcp_folder_selector" "all" {
+  id                  = "sel_gcp_folders"
+  name                = "All folders"
+  gcp_organization_id = "organizations/123"
+  when                = "true"
+}
+
+resource "commonfate_gcp_project_availabilities" "viewer" {
+  workflow_id                  = commonfate_access_workflow.default.id
+  gcp_role                     = "roles/viewer"
+  gcp_project_selector_id      = commonfate_gcp_project_selector.all.id
+  google_workspace_customer_id = "C123"
+}
+
+resource "commonfate_gcp_folder_availabilities" "viewer" {
+  workflow_id                  = commonfate_access_workflow.default.id
+  gcp_role                     = "roles/viewer"
+  gcp_folder_selector_id       = commonfate_gcp_folder_selector.all.id
+  google_workspace_customer_id = "C123"
+}
+
+resource "commonfate_webhook_integration" "audit" {
+  name = "audit"
+  url  = "https://audit.example.com"
+}
+
+resource "commonfate_webhook_integration" "siem" {
+  name = "siem"
+  url  = "https://siem.example.com"
+}
+
+resource "commonfate_opsgenie_integration" "oncall" {
+  name                = "oncall"
+  api_key_secret_path = "/secret"
+}
+
+resource "commonfate_access_workflow" "default" {
+  name                    = "default"
+  access_duration_seconds = 3600
+  priority                = 1
+}
+`
+
+func TestAccImportIDsDatasource(t *testing.T) {
+	s := acctest.NewServer(t)
+	s.PutPolicySet("prod", `permit(principal, action, resource);`)
+	s.PutPolicySet("dev", `permit(principal, action, resource);`)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(s) + importIDsResources,
+			},
+			{
+				Config: acctest.ProviderConfig(s) + importIDsResources + `
+data "commonfate_import_ids" "selectors" {
+  resource_type = "commonfate_selector"
+}
+
+data "commonfate_import_ids" "account_selectors" {
+  resource_type = "commonfate_aws_account_selector"
+}
+
+data "commonfate_import_ids" "policysets" {
+  resource_type = "commonfate_policyset"
+}
+
+data "commonfate_import_ids" "workflows" {
+  resource_type = "commonfate_access_workflow"
+}
+
+data "commonfate_import_ids" "webhook_integrations" {
+  resource_type = "commonfate_webhook_integration"
+}
+
+data "commonfate_import_ids" "availabilities" {
+  resource_type = "commonfate_availability_spec"
+}
+
+data "commonfate_import_ids" "gcp_project_availabilities" {
+  resource_type = "commonfate_gcp_project_availabilities"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.commonfate_import_ids.selectors", "ids.#", "4"),
+					resource.TestCheckResourceAttr("data.commonfate_import_ids.selectors", "ids.0", "sel_dev"),
+					resource.TestCheckResourceAttr("data.commonfate_import_ids.selectors", "ids.1", "sel_gcp"),
+					resource.TestCheckResourceAttr("data.commonfate_import_ids.selectors", "ids.2", "sel_gcp_folders"),
+					resource.TestCheckResourceAttr("data.commonfate_import_ids.selectors", "ids.3", "sel_prod"),
+					resource.TestCheckResourceAttr("data.commonfate_import_ids.account_selectors", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.commonfate_import_ids.account_selectors", "ids.0", "sel_dev"),
+					resource.TestCheckResourceAttr("data.commonfate_import_ids.account_selectors", "ids.1", "sel_prod"),
+					resource.TestCheckResourceAttr("data.commonfate_import_ids.policysets", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.commonfate_import_ids.policysets", "ids.0", "dev"),
+					resource.TestCheckResourceAttr("data.commonfate_import_ids.policysets", "ids.1", "prod"),
+					resource.TestCheckResourceAttr("data.commonfate_import_ids.workflows", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.commonfate_import_ids.workflows", "ids.0", "commonfate_access_workflow.default", "id"),
+					resource.TestCheckResourceAttr("data.commonfate_import_ids.webhook_integrations", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.commonfate_import_ids.availabilities", "ids.#", "2"),
+					// the folder availability has the same role and target types, and is only told apart by its selector.
+					resource.TestCheckResourceAttr("data.commonfate_import_ids.gcp_project_availabilities", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.commonfate_import_ids.gcp_project_availabilities", "ids.0", "commonfate_gcp_project_availabilities.viewer", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImportIDsDatasource_DeletedAvailability(t *testing.T) {
+	s := acctest.NewServer(t)
+	// the entity is still listed, but its availability spec has been deleted,
+	// as happens when an availability is deleted while the IDs are being listed.
+	s.PutEntity(&entityv1alpha1.Entity{Eid: &entityv1alpha1.EID{Type: "Access::Availability", Id: "av_deleted"}})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(s) + importIDsResources + `
+data "commonfate_import_ids" "gcp_project_availabilities" {
+  resource_type = "commonfate_gcp_project_availabilities"
+
+  depends_on = [commonfate_gcp_project_availabilities.viewer]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.commonfate_import_ids.gcp_project_availabilities", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.commonfate_import_ids.gcp_project_availabilities", "ids.0", "commonfate_gcp_project_availabilities.viewer", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImportIDsDatasource_UnsupportedType(t *testing.T) {
+	s := acctest.NewServer(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(s) + `
+data "commonfate_import_ids" "invalid" {
+  resource_type = "commonfate_aws_rds_availabilities"
+}
+`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
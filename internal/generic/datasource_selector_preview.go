@@ -0,0 +1,170 @@
+package generic
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"connectrpc.com/connect"
+	entityv1alpha1 "github.com/common-fate/sdk/gen/commonfate/entity/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/entity/v1alpha1/entityv1alpha1connect"
+	"github.com/common-fate/sdk/service/entity"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/common-fate/terraform-provider-commonfate/pkg/eid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SelectorPreviewModel struct {
+	ResourceType types.String   `tfsdk:"resource_type"`
+	BelongingTo  eid.EID        `tfsdk:"belonging_to"`
+	When         types.String   `tfsdk:"when"`
+	Matched      []MatchedModel `tfsdk:"matched"`
+}
+
+type MatchedModel struct {
+	EID  eid.EID      `tfsdk:"eid"`
+	Name types.String `tfsdk:"name"`
+}
+
+// SelectorPreviewDatasource evaluates a selector against the synced inventory,
+// so that the resources it matches can be reviewed in the plan.
+type SelectorPreviewDatasource struct {
+	client entityv1alpha1connect.EntityServiceClient
+}
+
+var _ datasource.DataSource = &SelectorPreviewDatasource{}
+
+// Metadata returns the data source type name.
+func (r *SelectorPreviewDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_selector_preview"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *SelectorPreviewDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	cfg := providerData.Config
+
+	client := entity.NewFromConfig(cfg)
+	r.client = client.RawClient()
+}
+
+func (r *SelectorPreviewDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Evaluates a selector's `when` expression against the resources which Common Fate has synced, and returns the resources it matches. " +
+			"Pass it the same `resource_type`, `belonging_to` and `when` as a `commonfate_selector` (or one of the typed selector resources) so that the resources a selector grants access to can be reviewed in the plan.",
+		Attributes: map[string]schema.Attribute{
+			"resource_type": schema.StringAttribute{
+				MarkdownDescription: "The type of resource to select, e.g. `AWS::Account`.",
+				Required:            true,
+			},
+			"belonging_to": schema.SingleNestedAttribute{
+				MarkdownDescription: "The overall parent that the selected resources must be a descendent of",
+				Required:            true,
+				Attributes:          eid.DataSourceEIDAttrs,
+			},
+			"when": schema.StringAttribute{
+				MarkdownDescription: "The Cedar expression to match resources on. If it isn't set, every resource of the type below `belonging_to` is matched.",
+				Optional:            true,
+			},
+			"matched": schema.ListNestedAttribute{
+				MarkdownDescription: "The resources which the selector matches, ordered by ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"eid": schema.SingleNestedAttribute{
+							MarkdownDescription: "The EID of the resource.",
+							Computed:            true,
+							Attributes:          eid.ComputedDataSourceEIDAttrs,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the resource, or its ID if it doesn't have a name.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *SelectorPreviewDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HTTP Client",
+			"Expected configured HTTP client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var state SelectorPreviewModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the API requires an expression, so a selector without one matches everything as it does in Common Fate.
+	when := "true"
+	if !state.When.IsNull() {
+		when = state.When.ValueString()
+	}
+
+	res, err := r.client.Select(ctx, connect.NewRequest(&entityv1alpha1.SelectRequest{
+		Universe:     "default",
+		BelongingTo:  state.BelongingTo.ToAPI(),
+		ResourceType: state.ResourceType.ValueString(),
+		When:         when,
+	}))
+	if connect.CodeOf(err) == connect.CodeInvalidArgument {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("when"),
+			"Invalid Selector",
+			err.Error(),
+		)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to evaluate Selector",
+			err.Error(),
+		)
+		return
+	}
+
+	entities := res.Msg.Entities
+	sort.Slice(entities, func(i, j int) bool {
+		return entities[i].Eid.Id < entities[j].Eid.Id
+	})
+
+	state.Matched = []MatchedModel{}
+	for _, e := range entities {
+		name := e.Eid.Id
+		for _, a := range e.Attributes {
+			if a.Key == "name" && a.Value.GetStr() != "" {
+				name = a.Value.GetStr()
+			}
+		}
+
+		state.Matched = append(state.Matched, MatchedModel{
+			EID:  eid.EIDFromAPI(e.Eid),
+			Name: types.StringValue(name),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,112 @@
+package generic_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSelectorPreviewDatasource(t *testing.T) {
+	s := acctest.NewServer(t)
+	putEntities(s)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(s) + `
+data "commonfate_selector_preview" "all" {
+  resource_type = "AWS::Account"
+
+  belonging_to = {
+    type = "AWS::Organization"
+    id   = "o-1"
+  }
+}
+
+data "commonfate_selector_preview" "prod" {
+  resource_type = "AWS::Account"
+  when          = "resource.name == \"prod\""
+
+  belonging_to = {
+    type = "AWS::Organization"
+    id   = "o-1"
+  }
+}
+
+data "commonfate_selector_preview" "null_when" {
+  resource_type = "AWS::Account"
+  when          = null
+
+  belonging_to = {
+    type = "AWS::Organization"
+    id   = "o-1"
+  }
+}
+
+data "commonfate_selector_preview" "ous" {
+  resource_type = "AWS::OrgUnit"
+
+  belonging_to = {
+    type = "AWS::Organization"
+    id   = "o-1"
+  }
+}
+
+// matched EIDs have the same shape as the EIDs which resources and data sources accept.
+data "commonfate_selector_preview" "in_ou" {
+  resource_type = "AWS::Account"
+  belonging_to  = data.commonfate_selector_preview.ous.matched[0].eid
+}
+
+output "matched" {
+  value = join(",", [for m in data.commonfate_selector_preview.all.matched : "${m.eid.type}::${m.eid.id}"])
+}
+
+data "commonfate_selector_preview" "none" {
+  resource_type = "AWS::Account"
+  when          = "resource.name == \"staging\""
+
+  belonging_to = {
+    type = "AWS::Organization"
+    id   = "o-1"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.commonfate_selector_preview.all", "matched.#", "2"),
+					resource.TestCheckResourceAttr("data.commonfate_selector_preview.all", "matched.0.eid.type", "AWS::Account"),
+					resource.TestCheckResourceAttr("data.commonfate_selector_preview.all", "matched.0.eid.id", "111111111111"),
+					resource.TestCheckResourceAttr("data.commonfate_selector_preview.all", "matched.0.name", "prod"),
+					resource.TestCheckResourceAttr("data.commonfate_selector_preview.all", "matched.1.name", "dev"),
+					resource.TestCheckResourceAttr("data.commonfate_selector_preview.prod", "matched.#", "1"),
+					resource.TestCheckResourceAttr("data.commonfate_selector_preview.prod", "matched.0.eid.id", "111111111111"),
+					resource.TestCheckResourceAttr("data.commonfate_selector_preview.null_when", "matched.#", "2"),
+					resource.TestCheckResourceAttr("data.commonfate_selector_preview.ous", "matched.#", "1"),
+					resource.TestCheckResourceAttr("data.commonfate_selector_preview.ous", "matched.0.eid.type", "AWS::OrgUnit"),
+					resource.TestCheckResourceAttr("data.commonfate_selector_preview.ous", "matched.0.eid.id", "ou-prod"),
+					resource.TestCheckResourceAttr("data.commonfate_selector_preview.in_ou", "matched.#", "1"),
+					resource.TestCheckResourceAttr("data.commonfate_selector_preview.in_ou", "matched.0.eid.id", "111111111111"),
+					resource.TestCheckOutput("matched", "AWS::Account::111111111111,AWS::Account::222222222222"),
+					resource.TestCheckResourceAttr("data.commonfate_selector_preview.none", "matched.#", "0"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig(s) + `
+data "commonfate_selector_preview" "invalid" {
+  resource_type = "AWS::Account"
+  when          = "resource.name like \"*\""
+
+  belonging_to = {
+    type = "AWS::Organization"
+    id   = "o-1"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Selector`),
+			},
+		},
+	})
+}
@@ -3,12 +3,14 @@ package generic
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
-	config_client "github.com/common-fate/sdk/config"
 	configv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1"
 	"github.com/common-fate/sdk/service/control/configsvc"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
 	"github.com/common-fate/terraform-provider-commonfate/pkg/eid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,17 +20,20 @@ import (
 )
 
 type AvailabilitySpec struct {
-	ID             types.String `tfsdk:"id"`
-	WorkflowID     types.String `tfsdk:"workflow_id"`
-	Role           eid.EID      `tfsdk:"role"`
-	Target         eid.EID      `tfsdk:"target"`
-	IdentityDomain *eid.EID     `tfsdk:"identity_domain"`
-	RolePriority   types.Int64  `tfsdk:"role_priority"`
+	ID             types.String   `tfsdk:"id"`
+	WorkflowID     types.String   `tfsdk:"workflow_id"`
+	Role           eid.EID        `tfsdk:"role"`
+	Target         eid.EID        `tfsdk:"target"`
+	IdentityDomain *eid.EID       `tfsdk:"identity_domain"`
+	RolePriority   types.Int64    `tfsdk:"role_priority"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 // AccessRuleResource is the data source implementation.
 type AvailabilitySpecResource struct {
-	client *configsvc.Client
+	client         *configsvc.Client
+	defaultTimeout time.Duration
+	deployment     providerdata.Deployment
 }
 
 var (
@@ -48,15 +53,18 @@ func (r *AvailabilitySpecResource) Configure(_ context.Context, req resource.Con
 		return
 	}
 
-	cfg, ok := req.ProviderData.(*config_client.Context)
+	providerData, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
+	cfg := providerData.Config
+	r.defaultTimeout = providerData.DefaultTimeout
+	r.deployment = providerData.Deployment
 	client := configsvc.NewFromConfig(cfg)
 
 	r.client = client
@@ -105,6 +113,14 @@ func (r *AvailabilitySpecResource) Schema(ctx context.Context, req resource.Sche
 			},
 		},
 		MarkdownDescription: `A specifier to make resources available for selection under a particular Access Workflow`,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -132,6 +148,15 @@ func (r *AvailabilitySpecResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	input := &configv1alpha1.CreateAvailabilitySpecRequest{
 		Role:       data.Role.ToAPI(),
 		WorkflowId: data.WorkflowID.ValueString(),
@@ -165,6 +190,7 @@ func (r *AvailabilitySpecResource) Create(ctx context.Context, req resource.Crea
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -181,6 +207,23 @@ func (r *AvailabilitySpecResource) Read(ctx context.Context, req resource.ReadRe
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	//read the state from the client
 	res, err := r.client.AvailabilitySpec().GetAvailabilitySpec(ctx, connect.NewRequest(&configv1alpha1.GetAvailabilitySpecRequest{
@@ -200,11 +243,13 @@ func (r *AvailabilitySpecResource) Read(ctx context.Context, req resource.ReadRe
 
 	state.ID = types.StringValue(res.Msg.AvailabilitySpec.Id)
 	state.WorkflowID = types.StringValue(res.Msg.AvailabilitySpec.WorkflowId)
+	state.RolePriority = types.Int64PointerValue(res.Msg.AvailabilitySpec.RolePriority)
 	state.Role = eid.EIDFromAPI(res.Msg.AvailabilitySpec.Role)
 	state.Target = eid.EIDFromAPI(res.Msg.AvailabilitySpec.Target)
 	state.IdentityDomain = eid.EIDPtrFromAPI(res.Msg.AvailabilitySpec.IdentityDomain)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *AvailabilitySpecResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -227,6 +272,20 @@ func (r *AvailabilitySpecResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	input := &configv1alpha1.AvailabilitySpec{
 		Id:         data.ID.ValueString(),
 		Role:       data.Role.ToAPI(),
@@ -272,6 +331,7 @@ func (r *AvailabilitySpecResource) Update(ctx context.Context, req resource.Upda
 	data.ID = types.StringValue(res.Msg.AvailabilitySpec.Id)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *AvailabilitySpecResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -294,6 +354,20 @@ func (r *AvailabilitySpecResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.AvailabilitySpec().DeleteAvailabilitySpec(ctx, connect.NewRequest(&configv1alpha1.DeleteAvailabilitySpecRequest{
 		Id: data.ID.ValueString(),
 	}))
@@ -313,4 +387,9 @@ func (r *AvailabilitySpecResource) Delete(ctx context.Context, req resource.Dele
 func (r *AvailabilitySpecResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// Read decodes role and target into structs, which can't hold a null object,
+	// so they are set to empty objects until Read fills them in.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), eid.EID{})...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target"), eid.EID{})...)
 }
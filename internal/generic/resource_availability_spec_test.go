@@ -0,0 +1,61 @@
+package generic_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccAvailabilitySpec(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_availability_spec.test",
+			Config: `
+resource "commonfate_availability_spec" "test" {
+  workflow_id = "aw_123"
+  role = {
+    type = "AWS::IDC::PermissionSet"
+    id   = "arn:aws:sso:::permissionSet/ssoins-123/ps-123"
+  }
+  target = {
+    type = "Access::Selector"
+    id   = "sel_123"
+  }
+  identity_domain = {
+    type = "AWS::IDC::IdentityStore"
+    id   = "d-123"
+  }
+}
+`,
+			Check: map[string]string{
+				"workflow_id":        "aw_123",
+				"role.type":          "AWS::IDC::PermissionSet",
+				"target.id":          "sel_123",
+				"identity_domain.id": "d-123",
+			},
+			UpdatedConfig: `
+resource "commonfate_availability_spec" "test" {
+  workflow_id   = "aw_456"
+  role_priority = 10
+  role = {
+    type = "AWS::IDC::PermissionSet"
+    id   = "arn:aws:sso:::permissionSet/ssoins-123/ps-123"
+  }
+  target = {
+    type = "Access::Selector"
+    id   = "sel_123"
+  }
+  identity_domain = {
+    type = "AWS::IDC::IdentityStore"
+    id   = "d-123"
+  }
+}
+`,
+			UpdatedCheck: map[string]string{
+				"workflow_id":   "aw_456",
+				"role_priority": "10",
+			},
+		},
+	})
+}
@@ -0,0 +1,97 @@
+package generic_test
+
+import (
+	"testing"
+
+	configv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1"
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSelector(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_selector.test",
+			Config: `
+resource "commonfate_selector" "test" {
+  id            = "test"
+  name          = "Test"
+  resource_type = "AWS::Account"
+  when          = "resource.tag_keys contains \"production\""
+  belonging_to = {
+    type = "AWS::Organization"
+    id   = "o-123"
+  }
+}
+`,
+			Check: map[string]string{
+				"id":              "test",
+				"name":            "Test",
+				"resource_type":   "AWS::Account",
+				"belonging_to.id": "o-123",
+			},
+			UpdatedConfig: `
+resource "commonfate_selector" "test" {
+  id            = "test"
+  name          = "Test Updated"
+  resource_type = "AWS::Account"
+  when          = "true"
+  belonging_to = {
+    type = "AWS::Organization"
+    id   = "o-123"
+  }
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name": "Test Updated",
+				"when": "true",
+			},
+		},
+	})
+}
+
+func TestAccSelector_EquivalentWhen(t *testing.T) {
+	s := acctest.NewServer(t)
+
+	config := acctest.ProviderConfig(s) + `
+resource "commonfate_selector" "test" {
+  id            = "test"
+  name          = "Test"
+  resource_type = "AWS::Account"
+  when          = "resource.name == \"prod\" || resource in AWS::OrgUnit::\"ou-123\""
+  belonging_to = {
+    type = "AWS::Organization"
+    id   = "o-123"
+  }
+}
+`
+
+	// editWhen changes the selector's when expression in the API.
+	editWhen := func(when string) {
+		if !s.EditSelector("test", func(sel *configv1alpha1.Selector) { sel.When = when }) {
+			t.Fatal("selector not found")
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				// the reformatted expression is equivalent, so there's nothing to change.
+				PreConfig: func() { editWhen("resource.name==\"prod\"\n  || (resource in AWS::OrgUnit::\"ou-123\")") },
+				Config:    config,
+				PlanOnly:  true,
+			},
+			{
+				PreConfig:          func() { editWhen("resource.name == \"dev\"") },
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,102 @@
+// Package integrations contains helpers shared by the integration data sources.
+package integrations
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// LookupAttributes adds the id and name attributes, which an integration is looked up by,
+// to the computed attributes of an integration data source.
+func LookupAttributes(kind string, attrs map[string]schema.Attribute) map[string]schema.Attribute {
+	attrs["id"] = schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("The ID of the %s integration to look up. Exactly one of `id` or `name` must be set.", kind),
+		Optional:            true,
+		Computed:            true,
+	}
+	attrs["name"] = schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("The name of the %s integration to look up. Exactly one of `id` or `name` must be set.", kind),
+		Optional:            true,
+		Computed:            true,
+	}
+	return attrs
+}
+
+// ConfigValidators requires exactly one of the id and name attributes to be set.
+func ConfigValidators() []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+// Lookup returns the integration with the given ID, or with the given name if the ID is null.
+// isKind reports whether an integration's config is of the type the data source reads,
+// so that looking up a name only matches integrations of that type.
+func Lookup(ctx context.Context, client integrationv1alpha1connect.IntegrationServiceClient, id, name types.String, kind string, isKind func(*integrationv1alpha1.Config) bool) (*integrationv1alpha1.Integration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !id.IsNull() {
+		res, err := client.GetIntegration(ctx, connect.NewRequest(&integrationv1alpha1.GetIntegrationRequest{
+			Id: id.ValueString(),
+		}))
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			diags.AddAttributeError(path.Root("id"), kind+" Integration Not Found", fmt.Sprintf("No integration with ID %q exists.", id.ValueString()))
+			return nil, diags
+		} else if err != nil {
+			diags.AddError("Failed to read "+kind+" Integration", err.Error())
+			return nil, diags
+		}
+
+		if !isKind(res.Msg.Integration.Config) {
+			diags.AddAttributeError(path.Root("id"), "Wrong Integration Type", fmt.Sprintf("Integration %q exists, but it is not of type %s.", id.ValueString(), kind))
+			return nil, diags
+		}
+		return res.Msg.Integration, diags
+	}
+
+	var found *integrationv1alpha1.Integration
+	pageToken := ""
+	for {
+		res, err := client.ListIntegrations(ctx, connect.NewRequest(&integrationv1alpha1.ListIntegrationsRequest{
+			PageToken: pageToken,
+		}))
+		if err != nil {
+			diags.AddError("Failed to list Integrations", err.Error())
+			return nil, diags
+		}
+
+		for _, integ := range res.Msg.Integrations {
+			if integ.Name != name.ValueString() || !isKind(integ.Config) {
+				continue
+			}
+			if found != nil {
+				diags.AddAttributeError(path.Root("name"), "Multiple "+kind+" Integrations Found", fmt.Sprintf("More than one %s integration is named %q (%s and %s). Look the integration up by id instead.", kind, integ.Name, found.Id, integ.Id))
+				return nil, diags
+			}
+			found = integ
+		}
+
+		if res.Msg.NextPageToken == "" {
+			break
+		}
+		pageToken = res.Msg.NextPageToken
+	}
+
+	if found == nil {
+		diags.AddAttributeError(path.Root("name"), kind+" Integration Not Found", fmt.Sprintf("No %s integration named %q exists.", kind, name.ValueString()))
+	}
+	return found, diags
+}
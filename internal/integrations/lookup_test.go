@@ -0,0 +1,116 @@
+package integrations_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+// the integrations share a name, so that looking up by name has to filter by type,
+// and there are enough of them that the integrations are listed across several pages.
+const lookupIntegrations = `
+resource "commonfate_okta_integration" "test" {
+  name                = "test"
+  organization_id     = "example"
+  api_key_secret_path = "/secret"
+}
+
+resource "commonfate_okta_integration" "other" {
+  name                = "other"
+  organization_id     = "other"
+  api_key_secret_path = "/secret"
+}
+
+resource "commonfate_datastax_integration" "test" {
+  name                = "test"
+  api_key_secret_path = "/datastax"
+}
+
+resource "commonfate_opsgenie_integration" "test" {
+  name                = "test"
+  api_key_secret_path = "/opsgenie"
+}
+`
+
+func TestAccLookup(t *testing.T) {
+	acctest.RunDatasourceTests(t, []acctest.DatasourceTest{
+		{
+			Name:       "name matches integration of the right type",
+			Datasource: "data.commonfate_opsgenie_integration.test",
+			Config: lookupIntegrations + `
+data "commonfate_opsgenie_integration" "test" {
+  name = "test"
+
+  depends_on = [
+    commonfate_okta_integration.test,
+    commonfate_okta_integration.other,
+    commonfate_datastax_integration.test,
+    commonfate_opsgenie_integration.test,
+  ]
+}
+`,
+			Check: map[string]string{
+				"name":                "test",
+				"api_key_secret_path": "/opsgenie",
+			},
+		},
+		{
+			Name: "id of another integration type",
+			Config: lookupIntegrations + `
+data "commonfate_okta_integration" "test" {
+  id = commonfate_datastax_integration.test.id
+}
+`,
+			ExpectError: regexp.MustCompile(`is not of type Okta`),
+		},
+		{
+			Name: "neither id nor name",
+			Config: `
+data "commonfate_okta_integration" "test" {}
+`,
+			ExpectError: regexp.MustCompile(`Missing Attribute Configuration`),
+		},
+		{
+			Name: "both id and name",
+			Config: `
+data "commonfate_okta_integration" "test" {
+  id   = "int_123"
+  name = "test"
+}
+`,
+			ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+		},
+	})
+}
+
+func TestAccLookup_MultipleMatches(t *testing.T) {
+	acctest.RunDatasourceTests(t, []acctest.DatasourceTest{
+		{
+			Name: "duplicate names",
+			Config: `
+resource "commonfate_okta_integration" "a" {
+  name                = "test"
+  organization_id     = "a"
+  api_key_secret_path = "/secret"
+}
+
+resource "commonfate_okta_integration" "b" {
+  name                = "test"
+  organization_id     = "b"
+  api_key_secret_path = "/secret"
+}
+
+data "commonfate_okta_integration" "test" {
+  name = "test"
+
+  depends_on = [
+    commonfate_okta_integration.a,
+    commonfate_okta_integration.b,
+  ]
+}
+`,
+			ExpectError: regexp.MustCompile(`Multiple Okta Integrations Found`),
+		},
+	})
+}
@@ -0,0 +1,60 @@
+package jira_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+const jiraIntegrationDatasourceResource = `
+resource "commonfate_jira_integration" "test" {
+  name                      = "test"
+  client_id                 = "client"
+  client_secret_secret_path = "/secret"
+}
+`
+
+func TestAccJiraIntegrationDatasource(t *testing.T) {
+	acctest.RunDatasourceTests(t, []acctest.DatasourceTest{
+		{
+			Name:       "by_id",
+			Datasource: "data.commonfate_jira_integration.test",
+			Config: jiraIntegrationDatasourceResource + `
+data "commonfate_jira_integration" "test" {
+  id = commonfate_jira_integration.test.id
+}
+`,
+			Check: map[string]string{
+				"name":                      "test",
+				"client_id":                 "client",
+				"client_secret_secret_path": "/secret",
+			},
+		},
+		{
+			Name:       "by_name",
+			Datasource: "data.commonfate_jira_integration.test",
+			Config: jiraIntegrationDatasourceResource + `
+data "commonfate_jira_integration" "test" {
+  name = "test"
+
+  depends_on = [commonfate_jira_integration.test]
+}
+`,
+			Check: map[string]string{
+				"name":                      "test",
+				"client_id":                 "client",
+				"client_secret_secret_path": "/secret",
+			},
+		},
+		{
+			Name: "not_found",
+			Config: `
+data "commonfate_jira_integration" "test" {
+  name = "missing"
+}
+`,
+			ExpectError: regexp.MustCompile(`Jira Integration Not Found`),
+		},
+	})
+}
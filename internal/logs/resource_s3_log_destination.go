@@ -3,13 +3,16 @@ package logs
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
-	config_client "github.com/common-fate/sdk/config"
+	"github.com/common-fate/grab"
 	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
 	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
 	"github.com/common-fate/sdk/service/control/integration"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
 	"github.com/common-fate/terraform-provider-commonfate/pkg/diags"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,19 +23,22 @@ import (
 )
 
 type S3LogDestinationModel struct {
-	ID                     types.String `tfsdk:"id"`
-	Name                   types.String `tfsdk:"name"`
-	BucketName             types.String `tfsdk:"bucket_name"`
-	RoleARN                types.String `tfsdk:"role_arn"`
-	KeyTemplate            types.String `tfsdk:"key_template"`
-	Compression            types.String `tfsdk:"compression"`
-	FilterForActions       types.Set    `tfsdk:"filter_for_actions"`
-	BatchDurationInMinutes types.Int64  `tfsdk:"batch_duration_in_minutes"`
-	MaximumBatchSize       types.Int64  `tfsdk:"maximum_batch_size"`
+	ID                     types.String   `tfsdk:"id"`
+	Name                   types.String   `tfsdk:"name"`
+	BucketName             types.String   `tfsdk:"bucket_name"`
+	RoleARN                types.String   `tfsdk:"role_arn"`
+	KeyTemplate            types.String   `tfsdk:"key_template"`
+	Compression            types.String   `tfsdk:"compression"`
+	FilterForActions       types.Set      `tfsdk:"filter_for_actions"`
+	BatchDurationInMinutes types.Int64    `tfsdk:"batch_duration_in_minutes"`
+	MaximumBatchSize       types.Int64    `tfsdk:"maximum_batch_size"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
 }
 
 type S3LogDestinationResource struct {
-	client integrationv1alpha1connect.IntegrationServiceClient
+	client         integrationv1alpha1connect.IntegrationServiceClient
+	defaultTimeout time.Duration
+	deployment     providerdata.Deployment
 }
 
 func NewS3LogDestinationResource() resource.Resource {
@@ -56,15 +62,18 @@ func (r *S3LogDestinationResource) Configure(_ context.Context, req resource.Con
 		return
 	}
 
-	cfg, ok := req.ProviderData.(*config_client.Context)
+	providerData, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
+	cfg := providerData.Config
+	r.defaultTimeout = providerData.DefaultTimeout
+	r.deployment = providerData.Deployment
 	client := integration.NewFromConfig(cfg)
 
 	r.client = client
@@ -140,6 +149,14 @@ Available actions that you can filter on include:
 
 Events are written in batches to the bucket in [JSONL format](https://jsonlines.org/) at a frequency specified by 'batch_duration_in_minutes'.
 `,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -166,6 +183,15 @@ func (r *S3LogDestinationResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var filters []string
 
 	resp.Diagnostics.Append(data.FilterForActions.ElementsAs(ctx, &filters, false)...)
@@ -208,6 +234,7 @@ func (r *S3LogDestinationResource) Create(ctx context.Context, req resource.Crea
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -224,6 +251,23 @@ func (r *S3LogDestinationResource) Read(ctx context.Context, req resource.ReadRe
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	//read the state from the client
 	res, err := r.client.GetIntegration(ctx, connect.NewRequest(&integrationv1alpha1.GetIntegrationRequest{
@@ -251,26 +295,32 @@ func (r *S3LogDestinationResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	state = S3LogDestinationModel{
+		Timeouts:               state.Timeouts,
 		ID:                     types.StringValue(state.ID.ValueString()),
 		Name:                   types.StringValue(res.Msg.Integration.Name),
 		BucketName:             types.StringValue(integ.BucketName),
 		RoleARN:                types.StringValue(integ.RoleArn),
-		KeyTemplate:            types.StringValue(integ.KeyTemplate),
-		Compression:            types.StringValue(integ.Compression),
+		KeyTemplate:            types.StringPointerValue(grab.If(integ.KeyTemplate == "", nil, &integ.KeyTemplate)),
+		Compression:            types.StringPointerValue(grab.If(integ.Compression == "", nil, &integ.Compression)),
 		BatchDurationInMinutes: types.Int64Value(int64(integ.BatchDurationMinutes)),
 		MaximumBatchSize:       types.Int64Value(int64(integ.MaximumBatchSize)),
 	}
 
-	filters, diags := types.SetValueFrom(ctx, types.StringType, integ.FilterForActions)
-	resp.Diagnostics.Append(diags...)
+	state.FilterForActions = types.SetNull(types.StringType)
 
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	if len(integ.FilterForActions) > 0 {
+		filters, diags := types.SetValueFrom(ctx, types.StringType, integ.FilterForActions)
+		resp.Diagnostics.Append(diags...)
 
-	state.FilterForActions = filters
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.FilterForActions = filters
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *S3LogDestinationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -293,6 +343,20 @@ func (r *S3LogDestinationResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var filters []string
 
 	resp.Diagnostics.Append(data.FilterForActions.ElementsAs(ctx, &filters, false)...)
@@ -338,6 +402,7 @@ func (r *S3LogDestinationResource) Update(ctx context.Context, req resource.Upda
 	data.ID = types.StringValue(res.Msg.Integration.Id)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *S3LogDestinationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -360,6 +425,20 @@ func (r *S3LogDestinationResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.DeleteIntegration(ctx, connect.NewRequest(&integrationv1alpha1.DeleteIntegrationRequest{
 		Id: data.ID.ValueString(),
 	}))
@@ -0,0 +1,48 @@
+package logs_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccS3LogDestination(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_s3_log_destination.test",
+			Config: `
+resource "commonfate_s3_log_destination" "test" {
+  name        = "test"
+  bucket_name = "audit-logs"
+  role_arn    = "arn:aws:iam::123456789012:role/logs"
+}
+`,
+			Check: map[string]string{
+				"name":        "test",
+				"bucket_name": "audit-logs",
+				"role_arn":    "arn:aws:iam::123456789012:role/logs",
+			},
+			UpdatedConfig: `
+resource "commonfate_s3_log_destination" "test" {
+  name                      = "test-updated"
+  bucket_name               = "audit-logs"
+  role_arn                  = "arn:aws:iam::123456789012:role/logs"
+  key_template              = "logs/{{.Date}}.json"
+  compression               = "gzip"
+  batch_duration_in_minutes = 10
+  maximum_batch_size        = 1000
+  filter_for_actions        = ["access.grant.activated"]
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name":                      "test-updated",
+				"key_template":              "logs/{{.Date}}.json",
+				"compression":               "gzip",
+				"batch_duration_in_minutes": "10",
+				"maximum_batch_size":        "1000",
+				"filter_for_actions.#":      "1",
+			},
+		},
+	})
+}
@@ -0,0 +1,179 @@
+package mockapi
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/cedar-policy/cedar-go"
+	"github.com/cedar-policy/cedar-go/types"
+	authzv1alpha1 "github.com/common-fate/sdk/gen/commonfate/authz/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/authz/v1alpha1/authzv1alpha1connect"
+	entityv1alpha1 "github.com/common-fate/sdk/gen/commonfate/entity/v1alpha1"
+)
+
+type policyService struct {
+	authzv1alpha1connect.UnimplementedPolicyServiceHandler
+	s *Server
+}
+
+// PutPolicySet stores a policyset with the given text, as if it had been changed outside of Terraform.
+func (s *Server) PutPolicySet(id, text string) {
+	s.policySets.put(id, &authzv1alpha1.PolicySet{Id: id, Text: text})
+}
+
+func (h *policyService) CreatePolicySet(ctx context.Context, req *connect.Request[authzv1alpha1.CreatePolicySetRequest]) (*connect.Response[authzv1alpha1.CreatePolicySetResponse], error) {
+	in := req.Msg.PolicySet
+	out, err := h.s.policySets.create(in.GetId(), &authzv1alpha1.PolicySet{
+		Id:   in.GetId(),
+		Text: in.GetText(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&authzv1alpha1.CreatePolicySetResponse{PolicySet: out}), nil
+}
+
+func (h *policyService) GetPolicySet(ctx context.Context, req *connect.Request[authzv1alpha1.GetPolicySetRequest]) (*connect.Response[authzv1alpha1.GetPolicySetResponse], error) {
+	out, err := h.s.policySets.get(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&authzv1alpha1.GetPolicySetResponse{PolicySet: out}), nil
+}
+
+func (h *policyService) ListPolicySets(ctx context.Context, req *connect.Request[authzv1alpha1.ListPolicySetsRequest]) (*connect.Response[authzv1alpha1.ListPolicySetsResponse], error) {
+	page, next, err := paginate(h.s.policySets.list(), req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&authzv1alpha1.ListPolicySetsResponse{PolicySets: page, NextPageToken: next}), nil
+}
+
+func (h *policyService) UpdatePolicySet(ctx context.Context, req *connect.Request[authzv1alpha1.UpdatePolicySetRequest]) (*connect.Response[authzv1alpha1.UpdatePolicySetResponse], error) {
+	in := req.Msg.PolicySet
+	out, err := h.s.policySets.update(in.GetId(), &authzv1alpha1.PolicySet{
+		Id:   in.GetId(),
+		Text: in.GetText(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&authzv1alpha1.UpdatePolicySetResponse{PolicySet: out}), nil
+}
+
+func (h *policyService) DeletePolicySet(ctx context.Context, req *connect.Request[authzv1alpha1.DeletePolicySetRequest]) (*connect.Response[authzv1alpha1.DeletePolicySetResponse], error) {
+	if err := h.s.policySets.delete(req.Msg.Id); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&authzv1alpha1.DeletePolicySetResponse{Id: req.Msg.Id}), nil
+}
+
+type authzService struct {
+	authzv1alpha1connect.UnimplementedAuthzServiceHandler
+	s *Server
+}
+
+// BatchAuthorize evaluates each request against every stored policyset, using the entities on the server.
+//
+// Only string, boolean and long attributes of entities are available to policies,
+// and requests are evaluated with an empty context. The overlay children of a request
+// are added to the entities for that request only.
+func (h *authzService) BatchAuthorize(ctx context.Context, req *connect.Request[authzv1alpha1.BatchAuthorizeRequest]) (*connect.Response[authzv1alpha1.BatchAuthorizeResponse], error) {
+	policies := cedar.NewPolicySet()
+	for _, ps := range h.s.policySets.list() {
+		list, err := cedar.NewPolicyListFromBytes(ps.Id, []byte(ps.Text))
+		if err != nil {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("policy set %s is invalid: %w", ps.Id, err))
+		}
+		for i, p := range list {
+			id := cedar.PolicyID(fmt.Sprintf("%s.%d", ps.Id, i))
+			if annotated, ok := p.Annotations()["id"]; ok {
+				id = cedar.PolicyID(annotated)
+			}
+			policies.Add(id, p)
+		}
+	}
+
+	entities := types.EntityMap{}
+	for _, e := range h.s.allEntities() {
+		uid := entityUID(e.Eid)
+
+		var parents []types.EntityUID
+		for _, p := range h.s.parentsOf(entityKey(e.Eid)) {
+			parents = append(parents, entityUID(p))
+		}
+
+		attrs := types.RecordMap{}
+		for _, a := range e.Attributes {
+			switch v := a.Value.GetValue().(type) {
+			case *entityv1alpha1.Value_Str:
+				attrs[types.String(a.Key)] = types.String(v.Str)
+			case *entityv1alpha1.Value_Bool:
+				attrs[types.String(a.Key)] = types.Boolean(v.Bool)
+			case *entityv1alpha1.Value_Long:
+				attrs[types.String(a.Key)] = types.Long(v.Long)
+			}
+		}
+
+		entities[uid] = types.Entity{
+			UID:        uid,
+			Parents:    types.NewEntityUIDSet(parents...),
+			Attributes: types.NewRecord(attrs),
+		}
+	}
+
+	out := &authzv1alpha1.BatchAuthorizeResponse{}
+	for i, r := range req.Msg.Requests {
+		if r.Principal == nil || r.Action == nil || r.Resource == nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("request %d: principal, action and resource are required", i))
+		}
+
+		requestEntities := entities
+		if len(r.OverlayChildren) > 0 {
+			requestEntities = types.EntityMap{}
+			for uid, e := range entities {
+				requestEntities[uid] = e
+			}
+			for _, c := range r.OverlayChildren {
+				uid := entityUID(c.Child)
+				e := requestEntities[uid]
+				e.UID = uid
+				e.Parents = types.NewEntityUIDSet(append(e.Parents.Slice(), entityUID(c.Parent))...)
+				requestEntities[uid] = e
+			}
+		}
+
+		decision, diag := policies.IsAuthorized(requestEntities, cedar.Request{
+			Principal: entityUID(r.Principal),
+			Action:    entityUID(r.Action),
+			Resource:  entityUID(r.Resource),
+			Context:   types.NewRecord(nil),
+		})
+
+		eval := &authzv1alpha1.Evaluation{
+			Id:          h.s.newID("eval"),
+			Request:     r,
+			Decision:    authzv1alpha1.Decision_DECISION_DENY,
+			Diagnostics: &authzv1alpha1.Diagnostics{},
+			ClientKey:   r.ClientKey,
+			Allowed:     bool(decision),
+		}
+		if decision == cedar.Allow {
+			eval.Decision = authzv1alpha1.Decision_DECISION_ALLOW
+		}
+		for _, reason := range diag.Reasons {
+			eval.Diagnostics.Reason = append(eval.Diagnostics.Reason, string(reason.PolicyID))
+		}
+		for _, e := range diag.Errors {
+			eval.Diagnostics.Errors = append(eval.Diagnostics.Errors, e.String())
+		}
+		out.Evaluations = append(out.Evaluations, eval)
+	}
+
+	return connect.NewResponse(out), nil
+}
+
+func entityUID(eid *entityv1alpha1.EID) types.EntityUID {
+	return types.NewEntityUID(types.EntityType(eid.GetType()), types.String(eid.GetId()))
+}
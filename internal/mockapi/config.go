@@ -0,0 +1,364 @@
+package mockapi
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	configv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1/configv1alpha1connect"
+)
+
+// EditAccessWorkflow changes a stored access workflow, as if it had been changed outside of Terraform.
+// It reports whether the access workflow exists.
+func (s *Server) EditAccessWorkflow(id string, fn func(*configv1alpha1.AccessWorkflow)) bool {
+	return s.workflows.edit(id, fn)
+}
+
+// EditSelector changes a stored selector, as if it had been changed outside of Terraform.
+// It reports whether the selector exists.
+func (s *Server) EditSelector(id string, fn func(*configv1alpha1.Selector)) bool {
+	return s.selectors.edit(id, fn)
+}
+
+type accessWorkflowService struct {
+	configv1alpha1connect.UnimplementedAccessWorkflowServiceHandler
+	s *Server
+}
+
+func (h *accessWorkflowService) CreateAccessWorkflow(ctx context.Context, req *connect.Request[configv1alpha1.CreateAccessWorkflowRequest]) (*connect.Response[configv1alpha1.CreateAccessWorkflowResponse], error) {
+	in := req.Msg
+	workflow := &configv1alpha1.AccessWorkflow{
+		Id:                     h.s.newID("aw"),
+		Name:                   in.Name,
+		AccessDuration:         in.AccessDuration,
+		TryExtendAfter:         in.TryExtendAfter,
+		Priority:               in.Priority,
+		ActivationExpiry:       in.ActivationExpiry,
+		DefaultDuration:        in.DefaultDuration,
+		Validation:             in.Validation,
+		ExtensionConditions:    in.ExtensionConditions,
+		RequestToApproveExpiry: in.RequestToApproveExpiry,
+		RequestToActiveExpiry:  in.RequestToActiveExpiry,
+		ApprovalSteps:          in.ApprovalSteps,
+	}
+
+	out, err := h.s.workflows.create(workflow.Id, workflow)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.CreateAccessWorkflowResponse{Workflow: out}), nil
+}
+
+func (h *accessWorkflowService) GetAccessWorkflow(ctx context.Context, req *connect.Request[configv1alpha1.GetAccessWorkflowRequest]) (*connect.Response[configv1alpha1.GetAccessWorkflowResponse], error) {
+	out, err := h.s.workflows.get(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.GetAccessWorkflowResponse{Workflow: out}), nil
+}
+
+func (h *accessWorkflowService) UpdateAccessWorkflow(ctx context.Context, req *connect.Request[configv1alpha1.UpdateAccessWorkflowRequest]) (*connect.Response[configv1alpha1.UpdateAccessWorkflowResponse], error) {
+	out, err := h.s.workflows.update(req.Msg.Workflow.GetId(), req.Msg.Workflow)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.UpdateAccessWorkflowResponse{Workflow: out}), nil
+}
+
+func (h *accessWorkflowService) DeleteAccessWorkflow(ctx context.Context, req *connect.Request[configv1alpha1.DeleteAccessWorkflowRequest]) (*connect.Response[configv1alpha1.DeleteAccessWorkflowResponse], error) {
+	if err := h.s.workflows.delete(req.Msg.Id); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.DeleteAccessWorkflowResponse{Id: req.Msg.Id}), nil
+}
+
+type selectorService struct {
+	configv1alpha1connect.UnimplementedSelectorServiceHandler
+	s *Server
+}
+
+func (h *selectorService) CreateSelector(ctx context.Context, req *connect.Request[configv1alpha1.CreateSelectorRequest]) (*connect.Response[configv1alpha1.CreateSelectorResponse], error) {
+	out, err := h.s.selectors.create(req.Msg.Selector.GetId(), req.Msg.Selector)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.CreateSelectorResponse{Selector: out}), nil
+}
+
+func (h *selectorService) GetSelector(ctx context.Context, req *connect.Request[configv1alpha1.GetSelectorRequest]) (*connect.Response[configv1alpha1.GetSelectorResponse], error) {
+	out, err := h.s.selectors.get(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.GetSelectorResponse{Selector: out}), nil
+}
+
+func (h *selectorService) ListSelectors(ctx context.Context, req *connect.Request[configv1alpha1.ListSelectorsRequest]) (*connect.Response[configv1alpha1.ListSelectorsResponse], error) {
+	page, next, err := paginate(h.s.selectors.list(), req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.ListSelectorsResponse{Selectors: page, NextPageToken: next}), nil
+}
+
+func (h *selectorService) UpdateSelector(ctx context.Context, req *connect.Request[configv1alpha1.UpdateSelectorRequest]) (*connect.Response[configv1alpha1.UpdateSelectorResponse], error) {
+	out, err := h.s.selectors.update(req.Msg.Selector.GetId(), req.Msg.Selector)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.UpdateSelectorResponse{Selector: out}), nil
+}
+
+func (h *selectorService) DeleteSelector(ctx context.Context, req *connect.Request[configv1alpha1.DeleteSelectorRequest]) (*connect.Response[configv1alpha1.DeleteSelectorResponse], error) {
+	if err := h.s.selectors.delete(req.Msg.Id); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.DeleteSelectorResponse{Id: req.Msg.Id}), nil
+}
+
+type availabilitySpecService struct {
+	configv1alpha1connect.UnimplementedAvailabilitySpecServiceHandler
+	s *Server
+}
+
+func (h *availabilitySpecService) CreateAvailabilitySpec(ctx context.Context, req *connect.Request[configv1alpha1.CreateAvailabilitySpecRequest]) (*connect.Response[configv1alpha1.CreateAvailabilitySpecResponse], error) {
+	in := req.Msg
+	spec := &configv1alpha1.AvailabilitySpec{
+		Id:             h.s.newID("as"),
+		WorkflowId:     in.WorkflowId,
+		Role:           in.Role,
+		Target:         in.Target,
+		IdentityDomain: in.IdentityDomain,
+		RolePriority:   in.RolePriority,
+	}
+
+	out, err := h.s.availabilitySpecs.create(spec.Id, spec)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.CreateAvailabilitySpecResponse{AvailabilitySpec: out}), nil
+}
+
+func (h *availabilitySpecService) GetAvailabilitySpec(ctx context.Context, req *connect.Request[configv1alpha1.GetAvailabilitySpecRequest]) (*connect.Response[configv1alpha1.GetAvailabilitySpecResponse], error) {
+	out, err := h.s.availabilitySpecs.get(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.GetAvailabilitySpecResponse{AvailabilitySpec: out}), nil
+}
+
+func (h *availabilitySpecService) UpdateAvailabilitySpec(ctx context.Context, req *connect.Request[configv1alpha1.UpdateAvailabilitySpecRequest]) (*connect.Response[configv1alpha1.UpdateAvailabilitySpecResponse], error) {
+	out, err := h.s.availabilitySpecs.update(req.Msg.AvailabilitySpec.GetId(), req.Msg.AvailabilitySpec)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.UpdateAvailabilitySpecResponse{AvailabilitySpec: out}), nil
+}
+
+func (h *availabilitySpecService) DeleteAvailabilitySpec(ctx context.Context, req *connect.Request[configv1alpha1.DeleteAvailabilitySpecRequest]) (*connect.Response[configv1alpha1.DeleteAvailabilitySpecResponse], error) {
+	if err := h.s.availabilitySpecs.delete(req.Msg.Id); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.DeleteAvailabilitySpecResponse{Id: req.Msg.Id}), nil
+}
+
+type slackAlertService struct {
+	configv1alpha1connect.UnimplementedSlackAlertServiceHandler
+	s *Server
+}
+
+func (h *slackAlertService) CreateSlackAlert(ctx context.Context, req *connect.Request[configv1alpha1.CreateSlackAlertRequest]) (*connect.Response[configv1alpha1.CreateSlackAlertResponse], error) {
+	in := req.Msg
+	alert := &configv1alpha1.SlackAlert{
+		Id:                            h.s.newID("sa"),
+		WorkflowId:                    in.WorkflowId,
+		SlackChannelId:                in.SlackChannelId,
+		SlackWorkspaceId:              in.SlackWorkspaceId,
+		IntegrationId:                 in.IntegrationId,
+		UseWebConsoleForApproveAction: in.UseWebConsoleForApproveAction,
+		SendDirectMessagesToApprovers: in.SendDirectMessagesToApprovers,
+		DisableInteractivityHandlers:  in.DisableInteractivityHandlers,
+		NotifyExpiryInSeconds:         in.NotifyExpiryInSeconds,
+	}
+
+	out, err := h.s.slackAlerts.create(alert.Id, alert)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.CreateSlackAlertResponse{Alert: out}), nil
+}
+
+func (h *slackAlertService) GetSlackAlert(ctx context.Context, req *connect.Request[configv1alpha1.GetSlackAlertRequest]) (*connect.Response[configv1alpha1.GetSlackAlertResponse], error) {
+	out, err := h.s.slackAlerts.get(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.GetSlackAlertResponse{Alert: out}), nil
+}
+
+func (h *slackAlertService) UpdateSlackAlert(ctx context.Context, req *connect.Request[configv1alpha1.UpdateSlackAlertRequest]) (*connect.Response[configv1alpha1.UpdateSlackAlertResponse], error) {
+	out, err := h.s.slackAlerts.update(req.Msg.Alert.GetId(), req.Msg.Alert)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.UpdateSlackAlertResponse{Alert: out}), nil
+}
+
+func (h *slackAlertService) DeleteSlackAlert(ctx context.Context, req *connect.Request[configv1alpha1.DeleteSlackAlertRequest]) (*connect.Response[configv1alpha1.DeleteSlackAlertResponse], error) {
+	if err := h.s.slackAlerts.delete(req.Msg.Id); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.DeleteSlackAlertResponse{Id: req.Msg.Id}), nil
+}
+
+type webhookProvisionerService struct {
+	configv1alpha1connect.UnimplementedWebhookProvisionerServiceHandler
+	s *Server
+}
+
+func (h *webhookProvisionerService) CreateWebhookProvisioner(ctx context.Context, req *connect.Request[configv1alpha1.CreateWebhookProvisionerRequest]) (*connect.Response[configv1alpha1.CreateWebhookProvisionerResponse], error) {
+	provisioner := &configv1alpha1.WebhookProvisioner{
+		Id:           h.s.newID("wp"),
+		Url:          req.Msg.Url,
+		Capabilities: req.Msg.Capabilities,
+	}
+
+	out, err := h.s.webhookProvisioners.create(provisioner.Id, provisioner)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.CreateWebhookProvisionerResponse{WebhookProvisioner: out}), nil
+}
+
+func (h *webhookProvisionerService) GetWebhookProvisioner(ctx context.Context, req *connect.Request[configv1alpha1.GetWebhookProvisionerRequest]) (*connect.Response[configv1alpha1.GetWebhookProvisionerResponse], error) {
+	out, err := h.s.webhookProvisioners.get(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.GetWebhookProvisionerResponse{WebhookProvisioner: out}), nil
+}
+
+func (h *webhookProvisionerService) UpdateWebhookProvisioner(ctx context.Context, req *connect.Request[configv1alpha1.UpdateWebhookProvisionerRequest]) (*connect.Response[configv1alpha1.UpdateWebhookProvisionerResponse], error) {
+	out, err := h.s.webhookProvisioners.update(req.Msg.WebhookProvisioner.GetId(), req.Msg.WebhookProvisioner)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.UpdateWebhookProvisionerResponse{WebhookProvisioner: out}), nil
+}
+
+func (h *webhookProvisionerService) DeleteWebhookProvisioner(ctx context.Context, req *connect.Request[configv1alpha1.DeleteWebhookProvisionerRequest]) (*connect.Response[configv1alpha1.DeleteWebhookProvisionerResponse], error) {
+	if err := h.s.webhookProvisioners.delete(req.Msg.Id); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.DeleteWebhookProvisionerResponse{Id: req.Msg.Id}), nil
+}
+
+type gcpRoleGroupService struct {
+	configv1alpha1connect.UnimplementedGCPRoleGroupServiceHandler
+	s *Server
+}
+
+func (h *gcpRoleGroupService) CreateGCPRoleGroup(ctx context.Context, req *connect.Request[configv1alpha1.CreateGCPRoleGroupRequest]) (*connect.Response[configv1alpha1.CreateGCPRoleGroupResponse], error) {
+	group := &configv1alpha1.GCPRoleGroup{
+		Id:             h.s.newID("rg"),
+		Name:           req.Msg.Name,
+		OrganizationId: req.Msg.OrganizationId,
+		RoleIds:        req.Msg.RoleIds,
+	}
+
+	out, err := h.s.gcpRoleGroups.create(group.Id, group)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.CreateGCPRoleGroupResponse{RoleGroup: out}), nil
+}
+
+func (h *gcpRoleGroupService) GetGCPRoleGroup(ctx context.Context, req *connect.Request[configv1alpha1.GetGCPRoleGroupRequest]) (*connect.Response[configv1alpha1.GetGCPRoleGroupResponse], error) {
+	out, err := h.s.gcpRoleGroups.get(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.GetGCPRoleGroupResponse{RoleGroup: out}), nil
+}
+
+func (h *gcpRoleGroupService) UpdateGCPRoleGroup(ctx context.Context, req *connect.Request[configv1alpha1.UpdateGCPRoleGroupRequest]) (*connect.Response[configv1alpha1.UpdateGCPRoleGroupResponse], error) {
+	out, err := h.s.gcpRoleGroups.update(req.Msg.RoleGroup.GetId(), req.Msg.RoleGroup)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.UpdateGCPRoleGroupResponse{RoleGroup: out}), nil
+}
+
+func (h *gcpRoleGroupService) DeleteGCPRoleGroup(ctx context.Context, req *connect.Request[configv1alpha1.DeleteGCPRoleGroupRequest]) (*connect.Response[configv1alpha1.DeleteGCPRoleGroupResponse], error) {
+	if err := h.s.gcpRoleGroups.delete(req.Msg.Id); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.DeleteGCPRoleGroupResponse{Id: req.Msg.Id}), nil
+}
+
+type awsResourceScannerService struct {
+	configv1alpha1connect.UnimplementedAWSResourceScannerServiceHandler
+	s *Server
+}
+
+func (h *awsResourceScannerService) CreateAWSResourceScanner(ctx context.Context, req *connect.Request[configv1alpha1.CreateAWSResourceScannerRequest]) (*connect.Response[configv1alpha1.CreateAWSResourceScannerResponse], error) {
+	in := req.Msg
+	scanner := &configv1alpha1.AWSResourceScanner{
+		Id:                  h.s.newID("rs"),
+		IntegrationId:       in.IntegrationId,
+		Regions:             in.Regions,
+		ResourceTypes:       in.ResourceTypes,
+		FilterForAccountIds: in.FilterForAccountIds,
+		RoleName:            in.RoleName,
+	}
+
+	out, err := h.s.resourceScanners.create(scanner.Id, scanner)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.CreateAWSResourceScannerResponse{ResourceScanner: out}), nil
+}
+
+func (h *awsResourceScannerService) GetAWSResourceScanner(ctx context.Context, req *connect.Request[configv1alpha1.GetAWSResourceScannerRequest]) (*connect.Response[configv1alpha1.GetAWSResourceScannerResponse], error) {
+	out, err := h.s.resourceScanners.get(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.GetAWSResourceScannerResponse{ResourceScanner: out}), nil
+}
+
+func (h *awsResourceScannerService) UpdateAWSResourceScanner(ctx context.Context, req *connect.Request[configv1alpha1.UpdateAWSResourceScannerRequest]) (*connect.Response[configv1alpha1.UpdateAWSResourceScannerResponse], error) {
+	out, err := h.s.resourceScanners.update(req.Msg.ResourceScanner.GetId(), req.Msg.ResourceScanner)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.UpdateAWSResourceScannerResponse{ResourceScanner: out}), nil
+}
+
+func (h *awsResourceScannerService) DeleteAWSResourceScanner(ctx context.Context, req *connect.Request[configv1alpha1.DeleteAWSResourceScannerRequest]) (*connect.Response[configv1alpha1.DeleteAWSResourceScannerResponse], error) {
+	if err := h.s.resourceScanners.delete(req.Msg.Id); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&configv1alpha1.DeleteAWSResourceScannerResponse{Id: req.Msg.Id}), nil
+}
+
+type deploymentService struct {
+	configv1alpha1connect.UnimplementedDeploymentServiceHandler
+	s *Server
+}
+
+func (h *deploymentService) GetDeploymentConfig(ctx context.Context, req *connect.Request[configv1alpha1.GetDeploymentConfigRequest]) (*connect.Response[configv1alpha1.GetDeploymentConfigResponse], error) {
+	clientID := ClientID
+	if id := h.s.terraformClientID.Load(); id != nil {
+		clientID = *id
+	}
+
+	// each server is a separate deployment, with its own issuer.
+	return connect.NewResponse(&configv1alpha1.GetDeploymentConfigResponse{
+		DeploymentConfig: &configv1alpha1.DeploymentConfig{
+			OidcIssuer:            h.s.URL,
+			SamlSsoEntityId:       "urn:amazon:cognito:sp:mockapi",
+			TerraformOidcClientId: clientID,
+		},
+	}), nil
+}
@@ -0,0 +1,175 @@
+package mockapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"connectrpc.com/connect"
+	entityv1alpha1 "github.com/common-fate/sdk/gen/commonfate/entity/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/entity/v1alpha1/entityv1alpha1connect"
+	"google.golang.org/protobuf/proto"
+)
+
+// pageSize is the number of items returned in each page of a paginated call.
+// It is small so that the provider's pagination is exercised by the tests.
+const pageSize = 2
+
+// workflowEntityType is the entity type which access workflows are exposed as.
+const workflowEntityType = "Access::Workflow"
+
+// availabilityEntityType is the entity type which availability specs are exposed as.
+const availabilityEntityType = "Access::Availability"
+
+// entityGraph holds the entities which would be synced by integrations in a real deployment,
+// along with their parent/child relationships.
+type entityGraph struct {
+	mu       sync.Mutex
+	entities map[string]*entityv1alpha1.Entity
+	parents  map[string][]*entityv1alpha1.EID
+}
+
+func newEntityGraph() *entityGraph {
+	return &entityGraph{
+		entities: map[string]*entityv1alpha1.Entity{},
+		parents:  map[string][]*entityv1alpha1.EID{},
+	}
+}
+
+func entityKey(eid *entityv1alpha1.EID) string {
+	return eid.GetType() + "::" + strconv.Quote(eid.GetId())
+}
+
+// PutEntity adds an entity to the server, as if it had been synced by an integration,
+// with the given entities as its parents.
+func (s *Server) PutEntity(e *entityv1alpha1.Entity, parents ...*entityv1alpha1.EID) {
+	s.entities.mu.Lock()
+	defer s.entities.mu.Unlock()
+
+	key := entityKey(e.Eid)
+	s.entities.entities[key] = proto.Clone(e).(*entityv1alpha1.Entity)
+	s.entities.parents[key] = append(s.entities.parents[key], parents...)
+}
+
+// allEntities returns every entity, including the access workflows and availability specs, ordered by EID.
+func (s *Server) allEntities() []*entityv1alpha1.Entity {
+	s.entities.mu.Lock()
+	out := make([]*entityv1alpha1.Entity, 0, len(s.entities.entities))
+	for _, e := range s.entities.entities {
+		out = append(out, proto.Clone(e).(*entityv1alpha1.Entity))
+	}
+	s.entities.mu.Unlock()
+
+	for _, w := range s.workflows.list() {
+		out = append(out, &entityv1alpha1.Entity{
+			Eid: &entityv1alpha1.EID{Type: workflowEntityType, Id: w.Id},
+			Attributes: []*entityv1alpha1.Attribute{
+				{Key: "name", Value: &entityv1alpha1.Value{Value: &entityv1alpha1.Value_Str{Str: w.Name}}},
+			},
+		})
+	}
+	for _, spec := range s.availabilitySpecs.list() {
+		out = append(out, &entityv1alpha1.Entity{
+			Eid: &entityv1alpha1.EID{Type: availabilityEntityType, Id: spec.Id},
+		})
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return entityKey(out[i].Eid) < entityKey(out[j].Eid)
+	})
+	return out
+}
+
+func (s *Server) getEntity(eid *entityv1alpha1.EID) (*entityv1alpha1.Entity, error) {
+	key := entityKey(eid)
+	for _, e := range s.allEntities() {
+		if entityKey(e.Eid) == key {
+			return e, nil
+		}
+	}
+	return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("entity %s not found", key))
+}
+
+// parentsOf returns the parents of the entity with the given key.
+func (s *Server) parentsOf(key string) []*entityv1alpha1.EID {
+	s.entities.mu.Lock()
+	defer s.entities.mu.Unlock()
+
+	return s.entities.parents[key]
+}
+
+// paginate returns the page of items starting at the page token.
+func paginate[T any](items []T, pageToken string) ([]T, string, error) {
+	start := 0
+	if pageToken != "" {
+		n, err := strconv.Atoi(pageToken)
+		if err != nil || n < 0 || n > len(items) {
+			return nil, "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page token %q", pageToken))
+		}
+		start = n
+	}
+
+	end := min(start+pageSize, len(items))
+	next := ""
+	if end < len(items) {
+		next = strconv.Itoa(end)
+	}
+	return items[start:end], next, nil
+}
+
+type entityService struct {
+	entityv1alpha1connect.UnimplementedEntityServiceHandler
+	s *Server
+}
+
+func (h *entityService) List(ctx context.Context, req *connect.Request[entityv1alpha1.ListRequest]) (*connect.Response[entityv1alpha1.ListResponse], error) {
+	var matched []*entityv1alpha1.Entity
+	for _, e := range h.s.allEntities() {
+		if req.Msg.Type == "" || e.Eid.Type == req.Msg.Type {
+			matched = append(matched, e)
+		}
+	}
+
+	page, next, err := paginate(matched, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&entityv1alpha1.ListResponse{Entities: page, NextPageToken: next}), nil
+}
+
+func (h *entityService) Get(ctx context.Context, req *connect.Request[entityv1alpha1.GetRequest]) (*connect.Response[entityv1alpha1.GetResponse], error) {
+	e, err := h.s.getEntity(req.Msg.Eid)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&entityv1alpha1.GetResponse{Entity: e}), nil
+}
+
+func (h *entityService) ListChildren(ctx context.Context, req *connect.Request[entityv1alpha1.ListChildrenRequest]) (*connect.Response[entityv1alpha1.ListChildrenResponse], error) {
+	parent := entityKey(req.Msg.Parent)
+
+	var children []*entityv1alpha1.EID
+	for _, e := range h.s.allEntities() {
+		for _, p := range h.s.parentsOf(entityKey(e.Eid)) {
+			if entityKey(p) == parent {
+				children = append(children, e.Eid)
+			}
+		}
+	}
+
+	page, next, err := paginate(children, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&entityv1alpha1.ListChildrenResponse{Children: page, NextPageToken: next}), nil
+}
+
+func (h *entityService) ListParents(ctx context.Context, req *connect.Request[entityv1alpha1.ListParentsRequest]) (*connect.Response[entityv1alpha1.ListParentsResponse], error) {
+	page, next, err := paginate(h.s.parentsOf(entityKey(req.Msg.Child)), req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&entityv1alpha1.ListParentsResponse{Parents: page, NextPageToken: next}), nil
+}
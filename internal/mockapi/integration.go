@@ -0,0 +1,65 @@
+package mockapi
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
+)
+
+type integrationService struct {
+	integrationv1alpha1connect.UnimplementedIntegrationServiceHandler
+	s *Server
+}
+
+func (h *integrationService) CreateIntegration(ctx context.Context, req *connect.Request[integrationv1alpha1.CreateIntegrationRequest]) (*connect.Response[integrationv1alpha1.CreateIntegrationResponse], error) {
+	integ := &integrationv1alpha1.Integration{
+		Id:     h.s.newID("int"),
+		Name:   req.Msg.Name,
+		Status: integrationv1alpha1.Status_STATUS_HEALTHY,
+		Config: req.Msg.Config,
+	}
+
+	out, err := h.s.integrations.create(integ.Id, integ)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&integrationv1alpha1.CreateIntegrationResponse{Integration: out}), nil
+}
+
+func (h *integrationService) GetIntegration(ctx context.Context, req *connect.Request[integrationv1alpha1.GetIntegrationRequest]) (*connect.Response[integrationv1alpha1.GetIntegrationResponse], error) {
+	out, err := h.s.integrations.get(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&integrationv1alpha1.GetIntegrationResponse{Integration: out}), nil
+}
+
+func (h *integrationService) ListIntegrations(ctx context.Context, req *connect.Request[integrationv1alpha1.ListIntegrationsRequest]) (*connect.Response[integrationv1alpha1.ListIntegrationsResponse], error) {
+	page, next, err := paginate(h.s.integrations.list(), req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&integrationv1alpha1.ListIntegrationsResponse{Integrations: page, NextPageToken: next}), nil
+}
+
+func (h *integrationService) UpdateIntegration(ctx context.Context, req *connect.Request[integrationv1alpha1.UpdateIntegrationRequest]) (*connect.Response[integrationv1alpha1.UpdateIntegrationResponse], error) {
+	integ := req.Msg.Integration
+	if integ != nil && integ.Status == integrationv1alpha1.Status_STATUS_UNSPECIFIED {
+		integ.Status = integrationv1alpha1.Status_STATUS_HEALTHY
+	}
+
+	out, err := h.s.integrations.update(integ.GetId(), integ)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&integrationv1alpha1.UpdateIntegrationResponse{Integration: out}), nil
+}
+
+func (h *integrationService) DeleteIntegration(ctx context.Context, req *connect.Request[integrationv1alpha1.DeleteIntegrationRequest]) (*connect.Response[integrationv1alpha1.DeleteIntegrationResponse], error) {
+	if err := h.s.integrations.delete(req.Msg.Id); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&integrationv1alpha1.DeleteIntegrationResponse{Id: req.Msg.Id}), nil
+}
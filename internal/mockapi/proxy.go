@@ -0,0 +1,239 @@
+package mockapi
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
+)
+
+type proxyService struct {
+	integrationv1alpha1connect.UnimplementedProxyServiceHandler
+	s *Server
+}
+
+func (h *proxyService) CreateProxy(ctx context.Context, req *connect.Request[integrationv1alpha1.CreateProxyRequest]) (*connect.Response[integrationv1alpha1.CreateProxyResponse], error) {
+	proxy := req.Msg
+	if proxy.Id == "" {
+		proxy.Id = h.s.newID("proxy")
+	}
+
+	out, err := h.s.proxies.create(proxy.Id, proxy)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&integrationv1alpha1.CreateProxyResponse{
+		Id:            out.Id,
+		IntegrationId: out.IntegrationId,
+		InstanceConfig: &integrationv1alpha1.CreateProxyResponse_AwsEcsProxyInstanceConfig{
+			AwsEcsProxyInstanceConfig: out.GetAwsEcsProxyInstanceConfig(),
+		},
+	}), nil
+}
+
+func (h *proxyService) GetProxy(ctx context.Context, req *connect.Request[integrationv1alpha1.GetProxyRequest]) (*connect.Response[integrationv1alpha1.GetProxyResponse], error) {
+	out, err := h.s.proxies.get(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&integrationv1alpha1.GetProxyResponse{
+		Id: out.Id,
+		InstanceConfig: &integrationv1alpha1.GetProxyResponse_AwsEcsProxyInstanceConfig{
+			AwsEcsProxyInstanceConfig: out.GetAwsEcsProxyInstanceConfig(),
+		},
+	}), nil
+}
+
+func (h *proxyService) UpdateProxy(ctx context.Context, req *connect.Request[integrationv1alpha1.UpdateProxyRequest]) (*connect.Response[integrationv1alpha1.UpdateProxyResponse], error) {
+	existing, err := h.s.proxies.get(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	existing.InstanceConfig = &integrationv1alpha1.CreateProxyRequest_AwsEcsProxyInstanceConfig{
+		AwsEcsProxyInstanceConfig: req.Msg.GetAwsEcsProxyInstanceConfig(),
+	}
+
+	out, err := h.s.proxies.update(existing.Id, existing)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&integrationv1alpha1.UpdateProxyResponse{
+		Id: out.Id,
+		InstanceConfig: &integrationv1alpha1.UpdateProxyResponse_AwsEcsProxyInstanceConfig{
+			AwsEcsProxyInstanceConfig: out.GetAwsEcsProxyInstanceConfig(),
+		},
+	}), nil
+}
+
+func (h *proxyService) DeleteProxy(ctx context.Context, req *connect.Request[integrationv1alpha1.DeleteProxyRequest]) (*connect.Response[integrationv1alpha1.DeleteProxyResponse], error) {
+	if err := h.s.proxies.delete(req.Msg.Id); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&integrationv1alpha1.DeleteProxyResponse{Id: req.Msg.Id}), nil
+}
+
+func (h *proxyService) ListProxyResources(ctx context.Context, req *connect.Request[integrationv1alpha1.ListProxyResourcesRequest]) (*connect.Response[integrationv1alpha1.ListProxyResourcesResponse], error) {
+	if _, err := h.s.proxies.get(req.Msg.ProxyId); err != nil {
+		return nil, err
+	}
+
+	res := &integrationv1alpha1.ListProxyResourcesResponse{ProxyId: req.Msg.ProxyId}
+	for _, db := range h.s.rdsDatabases.list() {
+		if db.ProxyId == req.Msg.ProxyId {
+			res.Resources = append(res.Resources, &integrationv1alpha1.Resource{
+				Resource: &integrationv1alpha1.Resource_AwsRdsDatabase{AwsRdsDatabase: db.RdsDatabase},
+			})
+		}
+	}
+	for _, cluster := range h.s.eksClusters.list() {
+		if cluster.ProxyId == req.Msg.ProxyId {
+			res.Resources = append(res.Resources, &integrationv1alpha1.Resource{
+				Resource: &integrationv1alpha1.Resource_AwsEksCluster{AwsEksCluster: cluster.EksCluster},
+			})
+		}
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (h *proxyService) CreateProxyRdsResource(ctx context.Context, req *connect.Request[integrationv1alpha1.CreateProxyRdsResourceRequest]) (*connect.Response[integrationv1alpha1.CreateProxyRdsResourceResponse], error) {
+	db := &integrationv1alpha1.CreateProxyRdsResourceResponse{
+		Id:          h.s.newID("rds"),
+		ProxyId:     req.Msg.ProxyId,
+		RdsDatabase: req.Msg.RdsDatabase,
+	}
+
+	out, err := h.s.rdsDatabases.create(db.Id, db)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(out), nil
+}
+
+func (h *proxyService) GetProxyRdsResource(ctx context.Context, req *connect.Request[integrationv1alpha1.GetProxyRdsResourceRequest]) (*connect.Response[integrationv1alpha1.GetProxyRdsResourceResponse], error) {
+	out, err := h.s.rdsDatabases.get(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&integrationv1alpha1.GetProxyRdsResourceResponse{
+		Id:          out.Id,
+		ProxyId:     out.ProxyId,
+		RdsDatabase: out.RdsDatabase,
+	}), nil
+}
+
+func (h *proxyService) UpdateProxyRdsResource(ctx context.Context, req *connect.Request[integrationv1alpha1.UpdateProxyRdsResourceRequest]) (*connect.Response[integrationv1alpha1.UpdateProxyRdsResourceResponse], error) {
+	out, err := h.s.rdsDatabases.update(req.Msg.Id, &integrationv1alpha1.CreateProxyRdsResourceResponse{
+		Id:          req.Msg.Id,
+		ProxyId:     req.Msg.ProxyId,
+		RdsDatabase: req.Msg.RdsDatabase,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&integrationv1alpha1.UpdateProxyRdsResourceResponse{
+		Id:          out.Id,
+		ProxyId:     out.ProxyId,
+		RdsDatabase: out.RdsDatabase,
+	}), nil
+}
+
+func (h *proxyService) DeleteProxyRdsResource(ctx context.Context, req *connect.Request[integrationv1alpha1.DeleteProxyRdsResourceRequest]) (*connect.Response[integrationv1alpha1.DeleteProxyRdsResourceResponse], error) {
+	if err := h.s.rdsDatabases.delete(req.Msg.Id); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&integrationv1alpha1.DeleteProxyRdsResourceResponse{Id: req.Msg.Id}), nil
+}
+
+func (h *proxyService) CreateProxyEksClusterResource(ctx context.Context, req *connect.Request[integrationv1alpha1.CreateProxyEksClusterResourceRequest]) (*connect.Response[integrationv1alpha1.CreateProxyEksClusterResourceResponse], error) {
+	cluster := &integrationv1alpha1.CreateProxyEksClusterResourceResponse{
+		Id:         h.s.newID("eks"),
+		ProxyId:    req.Msg.ProxyId,
+		EksCluster: req.Msg.EksCluster,
+	}
+
+	out, err := h.s.eksClusters.create(cluster.Id, cluster)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(out), nil
+}
+
+func (h *proxyService) GetProxyEksClusterResource(ctx context.Context, req *connect.Request[integrationv1alpha1.GetProxyEksClusterResourceRequest]) (*connect.Response[integrationv1alpha1.GetProxyEksClusterResourceResponse], error) {
+	out, err := h.s.eksClusters.get(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&integrationv1alpha1.GetProxyEksClusterResourceResponse{
+		Id:         out.Id,
+		EksCluster: out.EksCluster,
+	}), nil
+}
+
+func (h *proxyService) UpdateProxyEksClusterResource(ctx context.Context, req *connect.Request[integrationv1alpha1.UpdateProxyEksClusterResourceRequest]) (*connect.Response[integrationv1alpha1.UpdateProxyEksClusterResourceResponse], error) {
+	out, err := h.s.eksClusters.update(req.Msg.Id, &integrationv1alpha1.CreateProxyEksClusterResourceResponse{
+		Id:         req.Msg.Id,
+		ProxyId:    req.Msg.ProxyId,
+		EksCluster: req.Msg.EksCluster,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&integrationv1alpha1.UpdateProxyEksClusterResourceResponse{
+		Id:         out.Id,
+		ProxyId:    out.ProxyId,
+		EksCluster: out.EksCluster,
+	}), nil
+}
+
+func (h *proxyService) DeleteProxyEksClusterResource(ctx context.Context, req *connect.Request[integrationv1alpha1.DeleteProxyEksClusterResourceRequest]) (*connect.Response[integrationv1alpha1.DeleteProxyEksClusterResourceResponse], error) {
+	if err := h.s.eksClusters.delete(req.Msg.Id); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&integrationv1alpha1.DeleteProxyEksClusterResourceResponse{Id: req.Msg.Id}), nil
+}
+
+func (h *proxyService) CreateProxyEksServiceAccountResource(ctx context.Context, req *connect.Request[integrationv1alpha1.CreateProxyEksServiceAccountResourceRequest]) (*connect.Response[integrationv1alpha1.CreateProxyEksServiceAccountResourceResponse], error) {
+	account := &integrationv1alpha1.CreateProxyEksServiceAccountResourceResponse{
+		Id:             h.s.newID("eksa"),
+		ServiceAccount: req.Msg.ServiceAccount,
+	}
+
+	out, err := h.s.eksServiceAccounts.create(account.Id, account)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(out), nil
+}
+
+func (h *proxyService) GetProxyEksServiceAccountResource(ctx context.Context, req *connect.Request[integrationv1alpha1.GetProxyEksServiceAccountResourceRequest]) (*connect.Response[integrationv1alpha1.GetProxyEksServiceAccountResourceResponse], error) {
+	out, err := h.s.eksServiceAccounts.get(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&integrationv1alpha1.GetProxyEksServiceAccountResourceResponse{
+		Id:             out.Id,
+		ServiceAccount: out.ServiceAccount,
+	}), nil
+}
+
+func (h *proxyService) UpdateProxyEksServiceAccountResource(ctx context.Context, req *connect.Request[integrationv1alpha1.UpdateProxyEksServiceAccountResourceRequest]) (*connect.Response[integrationv1alpha1.UpdateProxyEksServiceAccountResourceResponse], error) {
+	out, err := h.s.eksServiceAccounts.update(req.Msg.Id, &integrationv1alpha1.CreateProxyEksServiceAccountResourceResponse{
+		Id:             req.Msg.Id,
+		ServiceAccount: req.Msg.ServiceAccount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&integrationv1alpha1.UpdateProxyEksServiceAccountResourceResponse{
+		Id:             out.Id,
+		ServiceAccount: out.ServiceAccount,
+	}), nil
+}
+
+func (h *proxyService) DeleteProxyEksServiceAccountResource(ctx context.Context, req *connect.Request[integrationv1alpha1.DeleteProxyEksServiceAccountResourceRequest]) (*connect.Response[integrationv1alpha1.DeleteProxyEksServiceAccountResourceResponse], error) {
+	if err := h.s.eksServiceAccounts.delete(req.Msg.Id); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&integrationv1alpha1.DeleteProxyEksServiceAccountResourceResponse{Id: req.Msg.Id}), nil
+}
@@ -0,0 +1,148 @@
+package mockapi
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	entityv1alpha1 "github.com/common-fate/sdk/gen/commonfate/entity/v1alpha1"
+)
+
+// condition is a single comparison in a selector's when expression.
+var condition = regexp.MustCompile(`^resource\.([A-Za-z_][A-Za-z0-9_]*)\s*(==|!=|contains)\s*(.+)$`)
+
+// Select returns the entities of the resource type below belonging_to which match the when expression.
+//
+// The server doesn't evaluate Cedar. It understands expressions made of 'true' and comparisons such as
+// resource.name == "prod" or resource.tags contains "team", joined with &&, which is enough for the tests.
+func (h *entityService) Select(ctx context.Context, req *connect.Request[entityv1alpha1.SelectRequest]) (*connect.Response[entityv1alpha1.SelectResponse], error) {
+	if req.Msg.ResourceType == "" || req.Msg.BelongingTo == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("resource_type and belonging_to are required"))
+	}
+	when := strings.TrimSpace(req.Msg.When)
+	if when == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("when is required"))
+	}
+	clauses := strings.Split(when, "&&")
+
+	out := []*entityv1alpha1.Entity{}
+	for _, e := range h.s.allEntities() {
+		if e.Eid.Type != req.Msg.ResourceType || !h.s.descendsFrom(entityKey(e.Eid), entityKey(req.Msg.BelongingTo)) {
+			continue
+		}
+
+		matched, err := matches(e, clauses)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if matched {
+			out = append(out, e)
+		}
+	}
+
+	return connect.NewResponse(&entityv1alpha1.SelectResponse{Entities: out}), nil
+}
+
+// descendsFrom reports whether the entity with the given key is below the ancestor in the entity graph.
+func (s *Server) descendsFrom(key, ancestor string) bool {
+	seen := map[string]bool{}
+	queue := []string{key}
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+
+		for _, p := range s.parentsOf(next) {
+			pk := entityKey(p)
+			if pk == ancestor {
+				return true
+			}
+			if !seen[pk] {
+				seen[pk] = true
+				queue = append(queue, pk)
+			}
+		}
+	}
+	return false
+}
+
+func matches(e *entityv1alpha1.Entity, clauses []string) (bool, error) {
+	for _, clause := range clauses {
+		clause = strings.TrimSpace(clause)
+		if clause == "true" {
+			continue
+		}
+
+		m := condition.FindStringSubmatch(clause)
+		if m == nil {
+			return false, fmt.Errorf("invalid when expression: unsupported condition %q", clause)
+		}
+		key, op, literal := m[1], m[2], strings.TrimSpace(m[3])
+
+		want, err := parseLiteral(literal)
+		if err != nil {
+			return false, fmt.Errorf("invalid when expression: %w", err)
+		}
+
+		var got *entityv1alpha1.Value
+		for _, a := range e.Attributes {
+			if a.Key == key {
+				got = a.Value
+			}
+		}
+
+		var ok bool
+		switch op {
+		case "==":
+			ok = got != nil && valueEqual(got, want)
+		case "!=":
+			ok = got == nil || !valueEqual(got, want)
+		case "contains":
+			for _, item := range got.GetSet().GetValues() {
+				if valueEqual(item, want) {
+					ok = true
+				}
+			}
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func parseLiteral(s string) (*entityv1alpha1.Value, error) {
+	switch {
+	case s == "true" || s == "false":
+		return &entityv1alpha1.Value{Value: &entityv1alpha1.Value_Bool{Bool: s == "true"}}, nil
+	case strings.HasPrefix(s, `"`):
+		str, err := strconv.Unquote(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid string literal %s", s)
+		}
+		return &entityv1alpha1.Value{Value: &entityv1alpha1.Value_Str{Str: str}}, nil
+	}
+
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported literal %s", s)
+	}
+	return &entityv1alpha1.Value{Value: &entityv1alpha1.Value_Long{Long: n}}, nil
+}
+
+func valueEqual(a, b *entityv1alpha1.Value) bool {
+	switch a := a.GetValue().(type) {
+	case *entityv1alpha1.Value_Str:
+		_, ok := b.GetValue().(*entityv1alpha1.Value_Str)
+		return ok && a.Str == b.GetStr()
+	case *entityv1alpha1.Value_Bool:
+		_, ok := b.GetValue().(*entityv1alpha1.Value_Bool)
+		return ok && a.Bool == b.GetBool()
+	case *entityv1alpha1.Value_Long:
+		_, ok := b.GetValue().(*entityv1alpha1.Value_Long)
+		return ok && a.Long == b.GetLong()
+	}
+	return false
+}
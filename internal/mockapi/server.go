@@ -0,0 +1,287 @@
+// Package mockapi is an in-memory stand-in for the Common Fate API.
+//
+// It serves the connect-rpc services called by the provider, along with a minimal
+// OIDC issuer for the client credentials flow, so that acceptance tests can run
+// against CommonFateProvider without a live deployment or network access.
+package mockapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"connectrpc.com/connect"
+	authzv1alpha1 "github.com/common-fate/sdk/gen/commonfate/authz/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/authz/v1alpha1/authzv1alpha1connect"
+	configv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1/configv1alpha1connect"
+	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
+	"github.com/common-fate/sdk/gen/commonfate/entity/v1alpha1/entityv1alpha1connect"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+const (
+	// ClientID is the OIDC client ID accepted by the server's token endpoint.
+	ClientID = "mockapi"
+	// ClientSecret is the OIDC client secret accepted by the server's token endpoint.
+	ClientSecret = "mockapi-secret"
+
+	accessToken = "mockapi-access-token"
+)
+
+// Server is a running in-memory Common Fate API.
+type Server struct {
+	// URL is the base URL of the server. It serves as the API URL,
+	// the authz URL and the OIDC issuer.
+	URL string
+
+	srv    *httptest.Server
+	nextID atomic.Int64
+
+	workflows           *store[*configv1alpha1.AccessWorkflow]
+	selectors           *store[*configv1alpha1.Selector]
+	availabilitySpecs   *store[*configv1alpha1.AvailabilitySpec]
+	slackAlerts         *store[*configv1alpha1.SlackAlert]
+	webhookProvisioners *store[*configv1alpha1.WebhookProvisioner]
+	gcpRoleGroups       *store[*configv1alpha1.GCPRoleGroup]
+	resourceScanners    *store[*configv1alpha1.AWSResourceScanner]
+	integrations        *store[*integrationv1alpha1.Integration]
+	proxies             *store[*integrationv1alpha1.CreateProxyRequest]
+	rdsDatabases        *store[*integrationv1alpha1.CreateProxyRdsResourceResponse]
+	eksClusters         *store[*integrationv1alpha1.CreateProxyEksClusterResourceResponse]
+	eksServiceAccounts  *store[*integrationv1alpha1.CreateProxyEksServiceAccountResourceResponse]
+	policySets          *store[*authzv1alpha1.PolicySet]
+	entities            *entityGraph
+
+	// collections are used to look up and remove objects regardless of their type.
+	collections []collection
+
+	faultMu sync.Mutex
+	// faults is the number of upcoming API calls which fail with faultCode.
+	faults    int
+	faultCode connect.Code
+	// latency is added to every API call.
+	latency time.Duration
+
+	// terraformClientID is the Terraform OIDC client ID returned in the deployment config.
+	terraformClientID atomic.Pointer[string]
+}
+
+// collection is implemented by every store, whatever type of object it holds.
+type collection interface {
+	has(id string) bool
+	remove(id string) bool
+}
+
+// New starts a new Server. Callers should call Close when finished with it.
+func New() *Server {
+	s := &Server{
+		workflows:           newStore[*configv1alpha1.AccessWorkflow]("access workflow"),
+		selectors:           newStore[*configv1alpha1.Selector]("selector"),
+		availabilitySpecs:   newStore[*configv1alpha1.AvailabilitySpec]("availability spec"),
+		slackAlerts:         newStore[*configv1alpha1.SlackAlert]("slack alert"),
+		webhookProvisioners: newStore[*configv1alpha1.WebhookProvisioner]("webhook provisioner"),
+		gcpRoleGroups:       newStore[*configv1alpha1.GCPRoleGroup]("GCP role group"),
+		resourceScanners:    newStore[*configv1alpha1.AWSResourceScanner]("AWS resource scanner"),
+		integrations:        newStore[*integrationv1alpha1.Integration]("integration"),
+		proxies:             newStore[*integrationv1alpha1.CreateProxyRequest]("proxy"),
+		rdsDatabases:        newStore[*integrationv1alpha1.CreateProxyRdsResourceResponse]("proxy RDS database"),
+		eksClusters:         newStore[*integrationv1alpha1.CreateProxyEksClusterResourceResponse]("proxy EKS cluster"),
+		eksServiceAccounts:  newStore[*integrationv1alpha1.CreateProxyEksServiceAccountResourceResponse]("proxy EKS service account"),
+		policySets:          newStore[*authzv1alpha1.PolicySet]("policy set"),
+		entities:            newEntityGraph(),
+	}
+
+	s.collections = []collection{
+		s.workflows,
+		s.selectors,
+		s.availabilitySpecs,
+		s.slackAlerts,
+		s.webhookProvisioners,
+		s.gcpRoleGroups,
+		s.resourceScanners,
+		s.integrations,
+		s.proxies,
+		s.rdsDatabases,
+		s.eksClusters,
+		s.eksServiceAccounts,
+		s.policySets,
+	}
+
+	opts := connect.WithInterceptors(authInterceptor(), s.faultInterceptor())
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", s.handleDiscovery)
+	mux.HandleFunc("/oauth2/token", s.handleToken)
+	mux.Handle(configv1alpha1connect.NewAccessWorkflowServiceHandler(&accessWorkflowService{s: s}, opts))
+	mux.Handle(configv1alpha1connect.NewSelectorServiceHandler(&selectorService{s: s}, opts))
+	mux.Handle(configv1alpha1connect.NewAvailabilitySpecServiceHandler(&availabilitySpecService{s: s}, opts))
+	mux.Handle(configv1alpha1connect.NewSlackAlertServiceHandler(&slackAlertService{s: s}, opts))
+	mux.Handle(configv1alpha1connect.NewWebhookProvisionerServiceHandler(&webhookProvisionerService{s: s}, opts))
+	mux.Handle(configv1alpha1connect.NewGCPRoleGroupServiceHandler(&gcpRoleGroupService{s: s}, opts))
+	mux.Handle(configv1alpha1connect.NewAWSResourceScannerServiceHandler(&awsResourceScannerService{s: s}, opts))
+	mux.Handle(configv1alpha1connect.NewDeploymentServiceHandler(&deploymentService{s: s}, opts))
+	mux.Handle(integrationv1alpha1connect.NewIntegrationServiceHandler(&integrationService{s: s}, opts))
+	mux.Handle(integrationv1alpha1connect.NewProxyServiceHandler(&proxyService{s: s}, opts))
+	mux.Handle(authzv1alpha1connect.NewPolicyServiceHandler(&policyService{s: s}, opts))
+	mux.Handle(authzv1alpha1connect.NewAuthzServiceHandler(&authzService{s: s}, opts))
+	mux.Handle(entityv1alpha1connect.NewEntityServiceHandler(&entityService{s: s}, opts))
+
+	// the authz policy client speaks gRPC over cleartext HTTP/2 when the API URL is http://,
+	// so the server needs to accept h2c connections alongside HTTP/1.1.
+	s.srv = httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+	s.URL = s.srv.URL
+
+	return s
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Exists reports whether an object with the given ID exists, whatever its type.
+func (s *Server) Exists(id string) bool {
+	for _, c := range s.collections {
+		if c.has(id) {
+			return true
+		}
+	}
+	return false
+}
+
+// Remove deletes the object with the given ID, whatever its type, to simulate
+// the object being deleted outside of Terraform. It reports whether an object was found.
+func (s *Server) Remove(id string) bool {
+	found := false
+	for _, c := range s.collections {
+		if c.remove(id) {
+			found = true
+		}
+	}
+	return found
+}
+
+// FailNext makes the next n API calls fail with the given code, to simulate transient errors.
+func (s *Server) FailNext(n int, code connect.Code) {
+	s.faultMu.Lock()
+	defer s.faultMu.Unlock()
+
+	s.faults = n
+	s.faultCode = code
+}
+
+// SetLatency makes every API call take at least d, to simulate a slow or hung API.
+func (s *Server) SetLatency(d time.Duration) {
+	s.faultMu.Lock()
+	defer s.faultMu.Unlock()
+
+	s.latency = d
+}
+
+// SetTerraformClientID changes the Terraform OIDC client ID in the deployment config, as happens when the client is rotated.
+func (s *Server) SetTerraformClientID(id string) {
+	s.terraformClientID.Store(&id)
+}
+
+// newID returns a unique ID for a newly created object.
+func (s *Server) newID(prefix string) string {
+	return fmt.Sprintf("%s_%04d", prefix, s.nextID.Add(1))
+}
+
+func (s *Server) handleDiscovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]any{
+		"issuer":                                s.URL,
+		"authorization_endpoint":                s.URL + "/oauth2/authorize",
+		"token_endpoint":                        s.URL + "/oauth2/token",
+		"jwks_uri":                              s.URL + "/oauth2/keys",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+
+	if r.PostForm.Get("grant_type") != "client_credentials" || clientID != ClientID || clientSecret != ClientSecret {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		writeJSON(w, map[string]any{"error": "invalid_client"})
+		return
+	}
+
+	writeJSON(w, map[string]any{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// authInterceptor rejects requests which don't carry the access token issued by the token endpoint.
+func authInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Header().Get("Authorization") != "Bearer "+accessToken {
+				return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing or invalid access token"))
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// faultInterceptor delays requests by the latency set with SetLatency,
+// and fails them while there are faults queued up by FailNext.
+//
+// The deployment config is fetched whenever the provider is configured, so it isn't
+// affected, leaving the faults for the calls made by resources and data sources.
+func (s *Server) faultInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().Procedure == configv1alpha1connect.DeploymentServiceGetDeploymentConfigProcedure {
+				return next(ctx, req)
+			}
+
+			s.faultMu.Lock()
+			fail := s.faults > 0
+			if fail {
+				s.faults--
+			}
+			code := s.faultCode
+			latency := s.latency
+			s.faultMu.Unlock()
+
+			select {
+			case <-ctx.Done():
+				return nil, connect.NewError(connect.CodeDeadlineExceeded, ctx.Err())
+			case <-time.After(latency):
+			}
+
+			if fail {
+				return nil, connect.NewError(code, errors.New("injected fault"))
+			}
+			return next(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package mockapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	config_client "github.com/common-fate/sdk/config"
+	configv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1"
+	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
+	"github.com/common-fate/sdk/service/authz/policyset"
+	"github.com/common-fate/sdk/service/control/configsvc"
+	"github.com/common-fate/sdk/service/control/integration"
+	"github.com/common-fate/sdk/tokenstore"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func newConfig(t *testing.T, s *Server, clientSecret string) (*config_client.Context, error) {
+	t.Helper()
+
+	return config_client.New(context.Background(), config_client.Opts{
+		APIURL:        s.URL,
+		AuthzURL:      s.URL,
+		ClientID:      ClientID,
+		ClientSecret:  clientSecret,
+		OIDCIssuer:    s.URL,
+		ConfigSources: []string{},
+		TokenStore:    tokenstore.NewInMemoryTokenStore(),
+	})
+}
+
+func TestServer_AccessWorkflowLifecycle(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	cfg, err := newConfig(t, s, ClientSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	client := configsvc.NewFromConfig(cfg).AccessWorkflow()
+
+	created, err := client.CreateAccessWorkflow(ctx, connect.NewRequest(&configv1alpha1.CreateAccessWorkflowRequest{
+		Name:           "test",
+		AccessDuration: durationpb.New(time.Hour),
+		Priority:       1,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := created.Msg.Workflow.Id
+	if id == "" {
+		t.Fatal("expected the created workflow to be assigned an ID")
+	}
+
+	workflow := created.Msg.Workflow
+	workflow.Name = "updated"
+	_, err = client.UpdateAccessWorkflow(ctx, connect.NewRequest(&configv1alpha1.UpdateAccessWorkflowRequest{Workflow: workflow}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := client.GetAccessWorkflow(ctx, connect.NewRequest(&configv1alpha1.GetAccessWorkflowRequest{Id: id}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Msg.Workflow.Name != "updated" {
+		t.Fatalf("expected name %q, got %q", "updated", got.Msg.Workflow.Name)
+	}
+
+	_, err = client.DeleteAccessWorkflow(ctx, connect.NewRequest(&configv1alpha1.DeleteAccessWorkflowRequest{Id: id}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetAccessWorkflow(ctx, connect.NewRequest(&configv1alpha1.GetAccessWorkflowRequest{Id: id}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("expected %s after delete, got %v", connect.CodeNotFound, err)
+	}
+}
+
+func TestServer_PolicySetOverGRPC(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	cfg, err := newConfig(t, s, ClientSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	client := policyset.NewFromConfig(cfg)
+
+	_, err = client.Create(ctx, policyset.CreateInput{PolicySet: policyset.Input{ID: "test", Text: "permit(principal, action, resource);"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Create(ctx, policyset.CreateInput{PolicySet: policyset.Input{ID: "test", Text: ""}})
+	if connect.CodeOf(err) != connect.CodeAlreadyExists {
+		t.Fatalf("expected %s for a duplicate ID, got %v", connect.CodeAlreadyExists, err)
+	}
+
+	got, err := client.Get(ctx, policyset.GetInput{ID: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.PolicySet.Text != "permit(principal, action, resource);" {
+		t.Fatalf("unexpected policy set text %q", got.PolicySet.Text)
+	}
+}
+
+func TestServer_Remove(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	cfg, err := newConfig(t, s, ClientSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	client := integration.NewFromConfig(cfg)
+
+	created, err := client.CreateIntegration(ctx, connect.NewRequest(&integrationv1alpha1.CreateIntegrationRequest{
+		Name: "test",
+		Config: &integrationv1alpha1.Config{
+			Config: &integrationv1alpha1.Config_Slack{Slack: &integrationv1alpha1.Slack{}},
+		},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !s.Remove(created.Msg.Integration.Id) {
+		t.Fatal("expected Remove to find the integration")
+	}
+	if s.Remove(created.Msg.Integration.Id) {
+		t.Fatal("expected Remove to report a missing object")
+	}
+
+	_, err = client.GetIntegration(ctx, connect.NewRequest(&integrationv1alpha1.GetIntegrationRequest{Id: created.Msg.Integration.Id}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("expected %s after remove, got %v", connect.CodeNotFound, err)
+	}
+}
+
+func TestServer_RejectsInvalidCredentials(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	_, err := newConfig(t, s, "wrong-secret")
+	if err == nil {
+		t.Fatal("expected an error when using an invalid client secret")
+	}
+}
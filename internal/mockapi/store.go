@@ -0,0 +1,125 @@
+package mockapi
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+// store is a concurrency-safe in-memory collection of API objects keyed by ID.
+// Objects are cloned on the way in and out so that callers can't mutate the stored copy.
+type store[T proto.Message] struct {
+	kind  string
+	mu    sync.Mutex
+	items map[string]T
+}
+
+func newStore[T proto.Message](kind string) *store[T] {
+	return &store[T]{kind: kind, items: map[string]T{}}
+}
+
+func (s *store[T]) get(id string) (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.items[id]
+	if !ok {
+		var empty T
+		return empty, connect.NewError(connect.CodeNotFound, fmt.Errorf("%s %q not found", s.kind, id))
+	}
+	return clone(item), nil
+}
+
+func (s *store[T]) create(id string, item T) (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.items[id]; ok {
+		var empty T
+		return empty, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("%s %q already exists", s.kind, id))
+	}
+	s.items[id] = clone(item)
+	return clone(item), nil
+}
+
+func (s *store[T]) update(id string, item T) (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.items[id]; !ok {
+		var empty T
+		return empty, connect.NewError(connect.CodeNotFound, fmt.Errorf("%s %q not found", s.kind, id))
+	}
+	s.items[id] = clone(item)
+	return clone(item), nil
+}
+
+func (s *store[T]) delete(id string) error {
+	if !s.remove(id) {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("%s %q not found", s.kind, id))
+	}
+	return nil
+}
+
+// put stores the object with the given ID, replacing any existing object.
+func (s *store[T]) put(id string, item T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.items[id] = clone(item)
+}
+
+// edit changes the object with the given ID in place, reporting whether it existed.
+func (s *store[T]) edit(id string, fn func(T)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.items[id]
+	if ok {
+		fn(item)
+	}
+	return ok
+}
+
+func (s *store[T]) has(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.items[id]
+	return ok
+}
+
+// remove deletes the object with the given ID, reporting whether it existed.
+func (s *store[T]) remove(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.items[id]
+	delete(s.items, id)
+	return ok
+}
+
+// list returns every stored object, ordered by ID.
+func (s *store[T]) list() []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := make([]string, 0, len(s.items))
+	for id := range s.items {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	out := make([]T, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, clone(s.items[id]))
+	}
+	return out
+}
+
+func clone[T proto.Message](item T) T {
+	return proto.Clone(item).(T)
+}
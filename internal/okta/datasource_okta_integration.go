@@ -0,0 +1,114 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
+	"github.com/common-fate/sdk/service/control/integration"
+	"github.com/common-fate/terraform-provider-commonfate/internal/integrations"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type OktaIntegrationDatasourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	OrganizationID   types.String `tfsdk:"organization_id"`
+	APIKeySecretPath types.String `tfsdk:"api_key_secret_path"`
+}
+
+// OktaIntegrationDatasource looks up an existing Okta integration by ID or name.
+type OktaIntegrationDatasource struct {
+	client integrationv1alpha1connect.IntegrationServiceClient
+}
+
+var (
+	_ datasource.DataSource                     = &OktaIntegrationDatasource{}
+	_ datasource.DataSourceWithConfigValidators = &OktaIntegrationDatasource{}
+)
+
+// Metadata returns the data source type name.
+func (r *OktaIntegrationDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_okta_integration"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *OktaIntegrationDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	cfg := providerData.Config
+
+	r.client = integration.NewFromConfig(cfg)
+}
+
+func (r *OktaIntegrationDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing Okta integration by ID or name.",
+		Attributes: integrations.LookupAttributes("Okta", map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "The Okta Organization ID",
+				Computed:            true,
+			},
+			"api_key_secret_path": schema.StringAttribute{
+				MarkdownDescription: "Path to secret for the Okta API Key",
+				Computed:            true,
+			},
+		}),
+	}
+}
+
+func (r *OktaIntegrationDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return integrations.ConfigValidators()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *OktaIntegrationDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HTTP Client",
+			"Expected configured HTTP client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data OktaIntegrationDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integ, diags := integrations.Lookup(ctx, r.client, data.Id, data.Name, "Okta", func(c *integrationv1alpha1.Config) bool {
+		return c.GetOkta() != nil
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := integ.Config.GetOkta()
+
+	state := OktaIntegrationDatasourceModel{
+		Id:               types.StringValue(integ.Id),
+		Name:             types.StringValue(integ.Name),
+		OrganizationID:   types.StringValue(config.OrganizationId),
+		APIKeySecretPath: types.StringValue(config.ApiKeySecretPath),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,60 @@
+package okta_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+const oktaIntegrationDatasourceResource = `
+resource "commonfate_okta_integration" "test" {
+  name                = "test"
+  organization_id     = "example"
+  api_key_secret_path = "/secret"
+}
+`
+
+func TestAccOktaIntegrationDatasource(t *testing.T) {
+	acctest.RunDatasourceTests(t, []acctest.DatasourceTest{
+		{
+			Name:       "by_id",
+			Datasource: "data.commonfate_okta_integration.test",
+			Config: oktaIntegrationDatasourceResource + `
+data "commonfate_okta_integration" "test" {
+  id = commonfate_okta_integration.test.id
+}
+`,
+			Check: map[string]string{
+				"name":                "test",
+				"organization_id":     "example",
+				"api_key_secret_path": "/secret",
+			},
+		},
+		{
+			Name:       "by_name",
+			Datasource: "data.commonfate_okta_integration.test",
+			Config: oktaIntegrationDatasourceResource + `
+data "commonfate_okta_integration" "test" {
+  name = "test"
+
+  depends_on = [commonfate_okta_integration.test]
+}
+`,
+			Check: map[string]string{
+				"name":                "test",
+				"organization_id":     "example",
+				"api_key_secret_path": "/secret",
+			},
+		},
+		{
+			Name: "not_found",
+			Config: `
+data "commonfate_okta_integration" "test" {
+  name = "missing"
+}
+`,
+			ExpectError: regexp.MustCompile(`Okta Integration Not Found`),
+		},
+	})
+}
@@ -0,0 +1,40 @@
+package okta_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccOktaGroupAvailabilities(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_okta_group_availabilities.test",
+			Config: `
+resource "commonfate_okta_group_availabilities" "test" {
+  workflow_id            = "aw_123"
+  okta_group_selector_id = "sel_123"
+  organization_id        = "example"
+}
+`,
+			Check: map[string]string{
+				"workflow_id":            "aw_123",
+				"okta_group_selector_id": "sel_123",
+				"organization_id":        "example",
+			},
+			UpdatedConfig: `
+resource "commonfate_okta_group_availabilities" "test" {
+  workflow_id            = "aw_456"
+  okta_group_selector_id = "sel_123"
+  organization_id        = "example"
+  role_priority          = 10
+}
+`,
+			UpdatedCheck: map[string]string{
+				"workflow_id":   "aw_456",
+				"role_priority": "10",
+			},
+		},
+	})
+}
@@ -0,0 +1,42 @@
+package okta_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccOktaGroupSelector(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_okta_group_selector.test",
+			Config: `
+resource "commonfate_okta_group_selector" "test" {
+  id              = "test"
+  name            = "Test"
+  organization_id = "example"
+  when            = "resource.name like \"*admins*\""
+}
+`,
+			Check: map[string]string{
+				"id":              "test",
+				"name":            "Test",
+				"organization_id": "example",
+				"when":            "resource.name like \"*admins*\"",
+			},
+			UpdatedConfig: `
+resource "commonfate_okta_group_selector" "test" {
+  id              = "test"
+  name            = "Test Updated"
+  organization_id = "example"
+  when            = "true"
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name": "Test Updated",
+				"when": "true",
+			},
+		},
+	})
+}
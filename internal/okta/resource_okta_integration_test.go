@@ -0,0 +1,39 @@
+package okta_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccOktaIntegration(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_okta_integration.test",
+			Config: `
+resource "commonfate_okta_integration" "test" {
+  name                = "test"
+  organization_id     = "example"
+  api_key_secret_path = "/secret"
+}
+`,
+			Check: map[string]string{
+				"name":                "test",
+				"organization_id":     "example",
+				"api_key_secret_path": "/secret",
+			},
+			UpdatedConfig: `
+resource "commonfate_okta_integration" "test" {
+  name                = "test-updated"
+  organization_id     = "example"
+  api_key_secret_path = "/secret-updated"
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name":                "test-updated",
+				"api_key_secret_path": "/secret-updated",
+			},
+		},
+	})
+}
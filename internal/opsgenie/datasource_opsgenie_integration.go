@@ -0,0 +1,108 @@
+package opsgenie
+
+import (
+	"context"
+	"fmt"
+
+	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
+	"github.com/common-fate/sdk/service/control/integration"
+	"github.com/common-fate/terraform-provider-commonfate/internal/integrations"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type OpsGenieIntegrationDatasourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	ApiKeySecretPath types.String `tfsdk:"api_key_secret_path"`
+}
+
+// OpsGenieIntegrationDatasource looks up an existing OpsGenie integration by ID or name.
+type OpsGenieIntegrationDatasource struct {
+	client integrationv1alpha1connect.IntegrationServiceClient
+}
+
+var (
+	_ datasource.DataSource                     = &OpsGenieIntegrationDatasource{}
+	_ datasource.DataSourceWithConfigValidators = &OpsGenieIntegrationDatasource{}
+)
+
+// Metadata returns the data source type name.
+func (r *OpsGenieIntegrationDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_opsgenie_integration"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *OpsGenieIntegrationDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	cfg := providerData.Config
+
+	r.client = integration.NewFromConfig(cfg)
+}
+
+func (r *OpsGenieIntegrationDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing OpsGenie integration by ID or name.",
+		Attributes: integrations.LookupAttributes("OpsGenie", map[string]schema.Attribute{
+			"api_key_secret_path": schema.StringAttribute{
+				MarkdownDescription: "Path to secret for API Key",
+				Computed:            true,
+			},
+		}),
+	}
+}
+
+func (r *OpsGenieIntegrationDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return integrations.ConfigValidators()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *OpsGenieIntegrationDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HTTP Client",
+			"Expected configured HTTP client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data OpsGenieIntegrationDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integ, diags := integrations.Lookup(ctx, r.client, data.Id, data.Name, "OpsGenie", func(c *integrationv1alpha1.Config) bool {
+		return c.GetOpsgenie() != nil
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := integ.Config.GetOpsgenie()
+
+	state := OpsGenieIntegrationDatasourceModel{
+		Id:               types.StringValue(integ.Id),
+		Name:             types.StringValue(integ.Name),
+		ApiKeySecretPath: types.StringValue(config.ApiKeySecretPath),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,57 @@
+package opsgenie_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+const opsGenieIntegrationDatasourceResource = `
+resource "commonfate_opsgenie_integration" "test" {
+  name                = "test"
+  api_key_secret_path = "/secret"
+}
+`
+
+func TestAccOpsGenieIntegrationDatasource(t *testing.T) {
+	acctest.RunDatasourceTests(t, []acctest.DatasourceTest{
+		{
+			Name:       "by_id",
+			Datasource: "data.commonfate_opsgenie_integration.test",
+			Config: opsGenieIntegrationDatasourceResource + `
+data "commonfate_opsgenie_integration" "test" {
+  id = commonfate_opsgenie_integration.test.id
+}
+`,
+			Check: map[string]string{
+				"name":                "test",
+				"api_key_secret_path": "/secret",
+			},
+		},
+		{
+			Name:       "by_name",
+			Datasource: "data.commonfate_opsgenie_integration.test",
+			Config: opsGenieIntegrationDatasourceResource + `
+data "commonfate_opsgenie_integration" "test" {
+  name = "test"
+
+  depends_on = [commonfate_opsgenie_integration.test]
+}
+`,
+			Check: map[string]string{
+				"name":                "test",
+				"api_key_secret_path": "/secret",
+			},
+		},
+		{
+			Name: "not_found",
+			Config: `
+data "commonfate_opsgenie_integration" "test" {
+  name = "missing"
+}
+`,
+			ExpectError: regexp.MustCompile(`OpsGenie Integration Not Found`),
+		},
+	})
+}
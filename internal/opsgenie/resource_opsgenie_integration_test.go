@@ -0,0 +1,36 @@
+package opsgenie_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccOpsGenieIntegration(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_opsgenie_integration.test",
+			Config: `
+resource "commonfate_opsgenie_integration" "test" {
+  name                = "test"
+  api_key_secret_path = "/secret"
+}
+`,
+			Check: map[string]string{
+				"name":                "test",
+				"api_key_secret_path": "/secret",
+			},
+			UpdatedConfig: `
+resource "commonfate_opsgenie_integration" "test" {
+  name                = "test-updated"
+  api_key_secret_path = "/secret-updated"
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name":                "test-updated",
+				"api_key_secret_path": "/secret-updated",
+			},
+		},
+	})
+}
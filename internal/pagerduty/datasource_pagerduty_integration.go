@@ -0,0 +1,114 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+
+	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
+	"github.com/common-fate/sdk/service/control/integration"
+	"github.com/common-fate/terraform-provider-commonfate/internal/integrations"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PagerDutyIntegrationDatasourceModel struct {
+	Id                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	ClientID               types.String `tfsdk:"client_id"`
+	ClientSecretSecretPath types.String `tfsdk:"client_secret_secret_path"`
+}
+
+// PagerDutyIntegrationDatasource looks up an existing PagerDuty integration by ID or name.
+type PagerDutyIntegrationDatasource struct {
+	client integrationv1alpha1connect.IntegrationServiceClient
+}
+
+var (
+	_ datasource.DataSource                     = &PagerDutyIntegrationDatasource{}
+	_ datasource.DataSourceWithConfigValidators = &PagerDutyIntegrationDatasource{}
+)
+
+// Metadata returns the data source type name.
+func (r *PagerDutyIntegrationDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pagerduty_integration"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *PagerDutyIntegrationDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	cfg := providerData.Config
+
+	r.client = integration.NewFromConfig(cfg)
+}
+
+func (r *PagerDutyIntegrationDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing PagerDuty integration by ID or name.",
+		Attributes: integrations.LookupAttributes("PagerDuty", map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The PagerDuty application Client ID",
+				Computed:            true,
+			},
+			"client_secret_secret_path": schema.StringAttribute{
+				MarkdownDescription: "Path to secret for Client Secret",
+				Computed:            true,
+			},
+		}),
+	}
+}
+
+func (r *PagerDutyIntegrationDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return integrations.ConfigValidators()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *PagerDutyIntegrationDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HTTP Client",
+			"Expected configured HTTP client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data PagerDutyIntegrationDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integ, diags := integrations.Lookup(ctx, r.client, data.Id, data.Name, "PagerDuty", func(c *integrationv1alpha1.Config) bool {
+		return c.GetPagerduty() != nil
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := integ.Config.GetPagerduty()
+
+	state := PagerDutyIntegrationDatasourceModel{
+		Id:                     types.StringValue(integ.Id),
+		Name:                   types.StringValue(integ.Name),
+		ClientID:               types.StringValue(config.ClientId),
+		ClientSecretSecretPath: types.StringValue(config.ClientSecretSecretPath),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,60 @@
+package pagerduty_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+const pagerDutyIntegrationDatasourceResource = `
+resource "commonfate_pagerduty_integration" "test" {
+  name                      = "test"
+  client_id                 = "client"
+  client_secret_secret_path = "/secret"
+}
+`
+
+func TestAccPagerDutyIntegrationDatasource(t *testing.T) {
+	acctest.RunDatasourceTests(t, []acctest.DatasourceTest{
+		{
+			Name:       "by_id",
+			Datasource: "data.commonfate_pagerduty_integration.test",
+			Config: pagerDutyIntegrationDatasourceResource + `
+data "commonfate_pagerduty_integration" "test" {
+  id = commonfate_pagerduty_integration.test.id
+}
+`,
+			Check: map[string]string{
+				"name":                      "test",
+				"client_id":                 "client",
+				"client_secret_secret_path": "/secret",
+			},
+		},
+		{
+			Name:       "by_name",
+			Datasource: "data.commonfate_pagerduty_integration.test",
+			Config: pagerDutyIntegrationDatasourceResource + `
+data "commonfate_pagerduty_integration" "test" {
+  name = "test"
+
+  depends_on = [commonfate_pagerduty_integration.test]
+}
+`,
+			Check: map[string]string{
+				"name":                      "test",
+				"client_id":                 "client",
+				"client_secret_secret_path": "/secret",
+			},
+		},
+		{
+			Name: "not_found",
+			Config: `
+data "commonfate_pagerduty_integration" "test" {
+  name = "missing"
+}
+`,
+			ExpectError: regexp.MustCompile(`PagerDuty Integration Not Found`),
+		},
+	})
+}
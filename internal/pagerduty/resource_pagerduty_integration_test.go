@@ -0,0 +1,39 @@
+package pagerduty_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccPagerDutyIntegration(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_pagerduty_integration.test",
+			Config: `
+resource "commonfate_pagerduty_integration" "test" {
+  name                      = "test"
+  client_id                 = "client"
+  client_secret_secret_path = "/secret"
+}
+`,
+			Check: map[string]string{
+				"name":                      "test",
+				"client_id":                 "client",
+				"client_secret_secret_path": "/secret",
+			},
+			UpdatedConfig: `
+resource "commonfate_pagerduty_integration" "test" {
+  name                      = "test-updated"
+  client_id                 = "client-updated"
+  client_secret_secret_path = "/secret"
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name":      "test-updated",
+				"client_id": "client-updated",
+			},
+		},
+	})
+}
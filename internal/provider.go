@@ -2,8 +2,12 @@ package internal
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"strings"
+	"time"
 
+	"connectrpc.com/connect"
 	config_client "github.com/common-fate/sdk/config"
 	"github.com/common-fate/terraform-provider-commonfate/internal/access"
 	"github.com/common-fate/terraform-provider-commonfate/internal/auth0"
@@ -17,14 +21,19 @@ import (
 	"github.com/common-fate/terraform-provider-commonfate/internal/okta"
 	"github.com/common-fate/terraform-provider-commonfate/internal/opsgenie"
 	"github.com/common-fate/terraform-provider-commonfate/internal/pagerduty"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
 	"github.com/common-fate/terraform-provider-commonfate/internal/proxy"
+	"github.com/common-fate/terraform-provider-commonfate/internal/retry"
 	"github.com/common-fate/terraform-provider-commonfate/internal/slack"
 	"github.com/common-fate/terraform-provider-commonfate/internal/snowflake"
 	"github.com/common-fate/terraform-provider-commonfate/internal/webhook"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -50,6 +59,19 @@ type CommonFateProviderModel struct {
 	OIDCClientId     types.String `tfsdk:"oidc_client_id"`
 	OIDCClientSecret types.String `tfsdk:"oidc_client_secret"`
 	OIDCIssuer       types.String `tfsdk:"oidc_issuer"`
+	ConfigSources    types.List   `tfsdk:"config_sources"`
+	Context          types.String `tfsdk:"context"`
+	DefaultTimeout   types.String `tfsdk:"default_timeout"`
+	Retry            *RetryModel  `tfsdk:"retry"`
+
+	SkipCredentialsValidation types.Bool `tfsdk:"skip_credentials_validation"`
+}
+
+// RetryModel configures how failed API requests are retried.
+type RetryModel struct {
+	MaxAttempts    types.Int64  `tfsdk:"max_attempts"`
+	BaseDelay      types.String `tfsdk:"base_delay"`
+	RetryableCodes types.List   `tfsdk:"retryable_codes"`
 }
 
 // Metadata returns the provider type name.
@@ -65,20 +87,62 @@ func (p *CommonFateProvider) Schema(ctx context.Context, req provider.SchemaRequ
 			"api_url": schema.StringAttribute{
 				Description: "The API url of your Common Fate deployment.",
 				Optional:    true,
+				Validators:  []validator.String{urlValidator{}},
 			},
 			"authz_url": schema.StringAttribute{
 				Description: "The base URL of the Common Fate authz service. If not provided, will default to the same URL as the api_url",
 				Optional:    true,
+				Validators:  []validator.String{urlValidator{}},
 			},
 			"oidc_client_id": schema.StringAttribute{
-				Optional: true,
+				Description: "The OIDC client ID used to authenticate to the Common Fate API.",
+				Optional:    true,
 			},
 			"oidc_client_secret": schema.StringAttribute{
-				Optional:  true,
-				Sensitive: true,
+				Description: "The OIDC client secret used to authenticate to the Common Fate API.",
+				Optional:    true,
+				Sensitive:   true,
 			},
 			"oidc_issuer": schema.StringAttribute{
-				Optional: true,
+				Description: "The URL of the OIDC issuer of your Common Fate deployment.",
+				Optional:    true,
+				Validators:  []validator.String{urlValidator{}},
+			},
+			"config_sources": schema.ListAttribute{
+				Description: "Where to load any of the above values which aren't set in the provider block from, in order of priority. Valid sources are 'env' (CF_ prefixed environment variables, such as CF_API_URL), 'file' (the Common Fate CLI config file, ~/.cf/config unless CF_CONFIG_FILE is set), or the URL of a deployment's config.json. Defaults to ['env'], or ['env', 'file'] if context is set.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"context": schema.StringAttribute{
+				Description: "The name of the Common Fate CLI context to load from the config file, when 'file' is one of the config_sources. If not provided, the config file's current context is used.",
+				Optional:    true,
+			},
+			"default_timeout": schema.StringAttribute{
+				Description: "How long each create, read, update and delete operation may take, such as '30s' or '5m', unless a resource's timeouts block says otherwise. Defaults to '10m'.",
+				Optional:    true,
+			},
+			"retry": schema.SingleNestedAttribute{
+				Description: "Configures how requests to the Common Fate API are retried when they fail with a transient error. If not provided, requests are attempted up to 3 times. Requests which create objects are only retried when the API is known not to have processed them, so that a timed out request can't create a duplicate.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"max_attempts": schema.Int64Attribute{
+						Description: "The maximum number of attempts made for each request, including the first. Set to 1 to disable retries. Defaults to 3.",
+						Optional:    true,
+					},
+					"base_delay": schema.StringAttribute{
+						Description: "The delay before the first retry, such as '500ms' or '2s'. The delay doubles after each attempt, up to 30 seconds. Defaults to '1s'.",
+						Optional:    true,
+					},
+					"retryable_codes": schema.ListAttribute{
+						Description: "The error codes which cause a request to be retried, such as 'unavailable'. Defaults to 'unavailable', 'resource_exhausted' and 'deadline_exceeded'.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				Description: "Skip the requests made when the provider is configured, which check that the OIDC issuer and the Common Fate API can be reached with the configured credentials. Defaults to false. The deployment is still identified, so that resources can't be changed through a provider configured for a different deployment.",
+				Optional:    true,
 			},
 		},
 	}
@@ -94,16 +158,35 @@ func (p *CommonFateProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
-	//using context.Background() here causes a cancelled context issue
-	//see https://github.com/databricks/databricks-sdk-go/issues/671
-	cfg, err := config_client.New(context.Background(), config_client.Opts{
-		APIURL:        config.APIURL.ValueString(),
-		ClientID:      config.OIDCClientId.ValueString(),
-		ClientSecret:  config.OIDCClientSecret.ValueString(),
-		OIDCIssuer:    strings.TrimSuffix(config.OIDCIssuer.ValueString(), "/"),
-		AuthzURL:      config.AuthzURL.ValueString(),
-		ConfigSources: []string{"env"},
-	})
+	// values set in the provider block take priority over the config sources.
+	opts := config_client.Opts{
+		APIURL:       config.APIURL.ValueString(),
+		ClientID:     config.OIDCClientId.ValueString(),
+		ClientSecret: config.OIDCClientSecret.ValueString(),
+		OIDCIssuer:   config.OIDCIssuer.ValueString(),
+		AuthzURL:     config.AuthzURL.ValueString(),
+	}
+
+	sourceNames := []string{"env"}
+	if !config.Context.IsNull() {
+		// selecting a CLI context implies reading it from the config file.
+		sourceNames = []string{"env", "file"}
+	}
+	if !config.ConfigSources.IsNull() {
+		sourceNames = nil
+		resp.Diagnostics.Append(config.ConfigSources.ElementsAs(ctx, &sourceNames, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	sources, err := newConfigSources(sourceNames, config.Context.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("config_sources"), "Invalid Config Sources", err.Error())
+		return
+	}
+
+	err = loadConfigSources(&opts, sources)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to load config",
@@ -113,18 +196,147 @@ func (p *CommonFateProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
+	opts.OIDCIssuer = strings.TrimSuffix(opts.OIDCIssuer, "/")
+
+	resp.Diagnostics.Append(checkLoadedOpts(opts)...)
+
+	policy, diags := config.Retry.policy(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	defaultTimeout, diags := parseDefaultTimeout(config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validate := !config.SkipCredentialsValidation.ValueBool()
+
+	if validate {
+		checkCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+		defer cancel()
+
+		resp.Diagnostics.Append(checkIssuer(checkCtx, http.DefaultClient, opts.OIDCIssuer)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// the values have already been loaded from the config sources above,
+	// so stop the SDK from loading them again in its own order.
+	opts.ConfigSources = []string{}
+
+	//using context.Background() here causes a cancelled context issue
+	//see https://github.com/databricks/databricks-sdk-go/issues/671
+	cfg, err := config_client.New(context.Background(), opts)
+	if err != nil {
+		resp.Diagnostics.Append(configError(err, opts)...)
+		return
+	}
+
+	// every API client is built from cfg.HTTPClient, so wrapping it applies the retry policy to all of them.
+	cfg.HTTPClient = retry.Wrap(cfg.HTTPClient, policy)
+
+	if validate {
+		checkCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+		defer cancel()
+
+		resp.Diagnostics.Append(checkAPI(checkCtx, cfg)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// the deployment is identified even when credentials validation is skipped,
+	// as the checks it enables protect resources rather than the provider configuration.
+	deploymentCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	deployment, diags := loadDeployment(deploymentCtx, cfg)
+	resp.Diagnostics.Append(diags...)
+
+	data := &providerdata.Data{
+		Config:         cfg,
+		DefaultTimeout: defaultTimeout,
+		Deployment:     deployment,
+	}
+
 	// // Make the Common Fate client available during DataSource and Resource
 	// // type Configure methods.
-	resp.DataSourceData = cfg
-	resp.ResourceData = cfg
+	resp.DataSourceData = data
+	resp.ResourceData = data
 
 	tflog.Debug(ctx, "Configured Common Fate client", map[string]any{"success": true})
 }
 
+// policy builds the retry policy from the provider configuration, falling back
+// to the default policy for anything which isn't set.
+func (m *RetryModel) policy(ctx context.Context) (retry.Policy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	policy := retry.DefaultPolicy()
+	if m == nil {
+		return policy, diags
+	}
+
+	if !m.MaxAttempts.IsNull() && !m.MaxAttempts.IsUnknown() {
+		if m.MaxAttempts.ValueInt64() < 1 {
+			diags.AddAttributeError(path.Root("retry").AtName("max_attempts"), "Invalid Retry Configuration", "max_attempts must be at least 1.")
+		}
+		policy.MaxAttempts = int(m.MaxAttempts.ValueInt64())
+	}
+
+	if !m.BaseDelay.IsNull() && !m.BaseDelay.IsUnknown() {
+		delay, err := time.ParseDuration(m.BaseDelay.ValueString())
+		if err != nil || delay < 0 {
+			diags.AddAttributeError(path.Root("retry").AtName("base_delay"), "Invalid Retry Configuration", fmt.Sprintf("base_delay must be a positive duration such as '500ms' or '2s', got %q.", m.BaseDelay.ValueString()))
+		}
+		policy.BaseDelay = delay
+	}
+
+	if !m.RetryableCodes.IsNull() && !m.RetryableCodes.IsUnknown() {
+		var names []string
+		diags.Append(m.RetryableCodes.ElementsAs(ctx, &names, false)...)
+
+		policy.RetryableCodes = nil
+		for i, name := range names {
+			var code connect.Code
+			if err := code.UnmarshalText([]byte(name)); err != nil {
+				diags.AddAttributeError(path.Root("retry").AtName("retryable_codes").AtListIndex(i), "Invalid Retry Configuration", fmt.Sprintf("%q is not a valid error code. Valid codes include 'unavailable', 'resource_exhausted' and 'deadline_exceeded'.", name))
+				continue
+			}
+			policy.RetryableCodes = append(policy.RetryableCodes, code)
+		}
+	}
+
+	return policy, diags
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *CommonFateProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewEcsProxyDatasource,
+		NewAccessWorkflowDatasource,
+		NewAccessWorkflowsDatasource,
+		NewEntitiesDatasource,
+		NewAuth0IntegrationDatasource,
+		NewAWSIDCIntegrationDatasource,
+		NewDataStaxIntegrationDatasource,
+		NewEntraIntegrationDatasource,
+		NewGCPIntegrationDatasource,
+		NewJiraIntegrationDatasource,
+		NewOktaIntegrationDatasource,
+		NewOpsGenieIntegrationDatasource,
+		NewPagerDutyIntegrationDatasource,
+		NewSlackIntegrationDatasource,
+		NewSnowflakeIntegrationDatasource,
+		NewWebhookIntegrationDatasource,
+		NewAWSIDCPermissionSetsDatasource,
+		NewGCPRolesDatasource,
+		NewSelectorPreviewDatasource,
+		NewPolicyTestDatasource,
+		NewPolicySetsDatasource,
+		NewImportIDsDatasource,
 	}
 }
 
@@ -377,6 +589,90 @@ func NewEcsProxyDatasource() datasource.DataSource {
 	return &proxy.ECSProxyDatasource{}
 }
 
+func NewAccessWorkflowDatasource() datasource.DataSource {
+	return &access.AccessWorkflowDatasource{}
+}
+
+func NewAccessWorkflowsDatasource() datasource.DataSource {
+	return &access.AccessWorkflowsDatasource{}
+}
+
+func NewEntitiesDatasource() datasource.DataSource {
+	return &generic.EntitiesDatasource{}
+}
+
+func NewAuth0IntegrationDatasource() datasource.DataSource {
+	return &auth0.Auth0IntegrationDatasource{}
+}
+
+func NewAWSIDCIntegrationDatasource() datasource.DataSource {
+	return &aws.AWSIDCIntegrationDatasource{}
+}
+
+func NewDataStaxIntegrationDatasource() datasource.DataSource {
+	return &datastax.DataStaxIntegrationDatasource{}
+}
+
+func NewEntraIntegrationDatasource() datasource.DataSource {
+	return &entra.EntraIntegrationDatasource{}
+}
+
+func NewGCPIntegrationDatasource() datasource.DataSource {
+	return &gcp.GCPIntegrationDatasource{}
+}
+
+func NewJiraIntegrationDatasource() datasource.DataSource {
+	return &jira.JiraIntegrationDatasource{}
+}
+
+func NewOktaIntegrationDatasource() datasource.DataSource {
+	return &okta.OktaIntegrationDatasource{}
+}
+
+func NewOpsGenieIntegrationDatasource() datasource.DataSource {
+	return &opsgenie.OpsGenieIntegrationDatasource{}
+}
+
+func NewPagerDutyIntegrationDatasource() datasource.DataSource {
+	return &pagerduty.PagerDutyIntegrationDatasource{}
+}
+
+func NewSlackIntegrationDatasource() datasource.DataSource {
+	return &slack.SlackIntegrationDatasource{}
+}
+
+func NewSnowflakeIntegrationDatasource() datasource.DataSource {
+	return &snowflake.SnowflakeIntegrationDatasource{}
+}
+
+func NewWebhookIntegrationDatasource() datasource.DataSource {
+	return &webhook.WebhookIntegrationDatasource{}
+}
+
+func NewAWSIDCPermissionSetsDatasource() datasource.DataSource {
+	return &aws.AWSIDCPermissionSetsDatasource{}
+}
+
+func NewGCPRolesDatasource() datasource.DataSource {
+	return &gcp.GCPRolesDatasource{}
+}
+
+func NewSelectorPreviewDatasource() datasource.DataSource {
+	return &generic.SelectorPreviewDatasource{}
+}
+
+func NewPolicyTestDatasource() datasource.DataSource {
+	return &access.PolicyTestDatasource{}
+}
+
+func NewPolicySetsDatasource() datasource.DataSource {
+	return &access.PolicySetsDatasource{}
+}
+
+func NewImportIDsDatasource() datasource.DataSource {
+	return &generic.ImportIDsDatasource{}
+}
+
 func NewRDSDatabaseResourceResource() resource.Resource {
 	return &proxy.RDSDatabaseResource{}
 }
@@ -0,0 +1,462 @@
+package internal_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+	"github.com/common-fate/terraform-provider-commonfate/internal/mockapi"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testWorkflowConfig = `
+resource "commonfate_access_workflow" "test" {
+  name                    = "test"
+  access_duration_seconds = 3600
+  priority                = 1
+}
+`
+
+// skipCredentialsValidation is added to provider blocks in tests which inject faults into the API.
+const skipCredentialsValidation = `
+  skip_credentials_validation = true`
+
+func TestAccProvider_Retry(t *testing.T) {
+	tests := []struct {
+		name        string
+		retry       string
+		faults      int
+		code        connect.Code
+		expectError *regexp.Regexp
+	}{
+		{
+			name: "retries transient errors",
+			retry: `
+  retry = {
+    max_attempts = 3
+    base_delay   = "10ms"
+  }`,
+			faults: 2,
+			code:   connect.CodeUnavailable,
+		},
+		{
+			name: "gives up after max attempts",
+			retry: `
+  retry = {
+    max_attempts = 2
+    base_delay   = "10ms"
+  }`,
+			faults:      2,
+			code:        connect.CodeResourceExhausted,
+			expectError: regexp.MustCompile(`resource_exhausted`),
+		},
+		{
+			name: "doesn't retry other codes",
+			retry: `
+  retry = {
+    max_attempts    = 3
+    base_delay      = "10ms"
+    retryable_codes = ["unavailable"]
+  }`,
+			faults:      1,
+			code:        connect.CodeResourceExhausted,
+			expectError: regexp.MustCompile(`resource_exhausted`),
+		},
+		{
+			name: "invalid code",
+			retry: `
+  retry = {
+    retryable_codes = ["sometimes"]
+  }`,
+			expectError: regexp.MustCompile(`"sometimes" is not a valid error code`),
+		},
+		{
+			name: "invalid delay",
+			retry: `
+  retry = {
+    base_delay = "soon"
+  }`,
+			expectError: regexp.MustCompile(`base_delay must be a positive duration`),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			s := acctest.NewServer(t)
+			resource.ParallelTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						PreConfig: func() {
+							s.FailNext(tt.faults, tt.code)
+						},
+						// the faults are meant for the resource, not the checks made when the provider is configured.
+						Config:      acctest.ProviderConfigWith(s, tt.retry+skipCredentialsValidation) + testWorkflowConfig,
+						ExpectError: tt.expectError,
+						Check:       resource.TestCheckResourceAttrSet("commonfate_access_workflow.test", "id"),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccProvider_Timeouts(t *testing.T) {
+	tests := []struct {
+		name        string
+		provider    string
+		resource    string
+		expectError *regexp.Regexp
+	}{
+		{
+			name:        "default timeout",
+			provider:    `  default_timeout = "100ms"`,
+			resource:    testWorkflowConfig,
+			expectError: regexp.MustCompile(`deadline_exceeded`),
+		},
+		{
+			name:     "resource timeouts override the default",
+			provider: `  default_timeout = "100ms"`,
+			resource: `
+resource "commonfate_access_workflow" "test" {
+  name                    = "test"
+  access_duration_seconds = 3600
+  priority                = 1
+
+  timeouts {
+    create = "10s"
+    read   = "10s"
+    delete = "10s"
+  }
+}
+`,
+		},
+		{
+			name: "resource timeout",
+			resource: `
+resource "commonfate_access_workflow" "test" {
+  name                    = "test"
+  access_duration_seconds = 3600
+  priority                = 1
+
+  timeouts {
+    create = "100ms"
+  }
+}
+`,
+			expectError: regexp.MustCompile(`deadline_exceeded`),
+		},
+		{
+			name:        "invalid default timeout",
+			provider:    `  default_timeout = "soon"`,
+			resource:    testWorkflowConfig,
+			expectError: regexp.MustCompile(`default_timeout must be a positive duration`),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			s := acctest.NewServer(t)
+			resource.ParallelTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						PreConfig: func() {
+							s.SetLatency(500 * time.Millisecond)
+						},
+						Config:      acctest.ProviderConfigWith(s, tt.provider+skipCredentialsValidation) + tt.resource,
+						ExpectError: tt.expectError,
+						Check:       resource.TestCheckResourceAttrSet("commonfate_access_workflow.test", "id"),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccProvider_ConfigSources(t *testing.T) {
+	s := acctest.NewServer(t)
+
+	configFile := filepath.Join(t.TempDir(), "config")
+	err := os.WriteFile(configFile, []byte(fmt.Sprintf(`
+current_context = "default"
+
+[context.default]
+api_url = "https://commonfate.invalid"
+
+[context.mock]
+api_url = %q
+oidc_issuer = %q
+oidc_client_id = %q
+oidc_client_secret = %q
+`, s.URL, s.URL, mockapi.ClientID, mockapi.ClientSecret)), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("CF_CONFIG_FILE", configFile)
+
+	tests := []struct {
+		name        string
+		provider    string
+		env         map[string]string
+		expectError *regexp.Regexp
+	}{
+		{
+			name: "named context",
+			provider: `
+provider "commonfate" {
+  context = "mock"
+}
+`,
+		},
+		{
+			name: "environment variables",
+			provider: `
+provider "commonfate" {
+  config_sources = ["env"]
+}
+`,
+			env: map[string]string{
+				"CF_API_URL":            s.URL,
+				"CF_OIDC_ISSUER":        s.URL,
+				"CF_OIDC_CLIENT_ID":     mockapi.ClientID,
+				"CF_OIDC_CLIENT_SECRET": mockapi.ClientSecret,
+			},
+		},
+		{
+			name: "provider block takes priority",
+			provider: fmt.Sprintf(`
+provider "commonfate" {
+  api_url = %q
+  context = "mock"
+}
+`, s.URL),
+			env: map[string]string{
+				"CF_API_URL": "https://commonfate.invalid",
+			},
+		},
+		{
+			// the provider block and config sources can each provide some of the values.
+			name: "provider block and environment variables",
+			provider: fmt.Sprintf(`
+provider "commonfate" {
+  api_url        = %q
+  oidc_client_id = %q
+}
+`, s.URL, mockapi.ClientID),
+			env: map[string]string{
+				"CF_OIDC_ISSUER":        s.URL,
+				"CF_OIDC_CLIENT_SECRET": mockapi.ClientSecret,
+			},
+		},
+		{
+			name: "unknown context",
+			provider: `
+provider "commonfate" {
+  context = "missing"
+}
+`,
+			expectError: regexp.MustCompile(`could not find context "missing"`),
+		},
+		{
+			name: "invalid source",
+			provider: `
+provider "commonfate" {
+  config_sources = ["keychain"]
+}
+`,
+			expectError: regexp.MustCompile(`invalid config source "keychain"`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config:      tt.provider + testWorkflowConfig,
+						ExpectError: tt.expectError,
+						Check:       resource.TestCheckResourceAttrSet("commonfate_access_workflow.test", "id"),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccProvider_Validation(t *testing.T) {
+	s := acctest.NewServer(t)
+
+	tests := []struct {
+		name        string
+		provider    string
+		expectError *regexp.Regexp
+	}{
+		{
+			name: "valid",
+			provider: fmt.Sprintf(`
+provider "commonfate" {
+  api_url            = %q
+  oidc_issuer        = %q
+  oidc_client_id     = %q
+  oidc_client_secret = %q
+}
+`, s.URL, s.URL, mockapi.ClientID, mockapi.ClientSecret),
+		},
+		{
+			name: "client ID without issuer",
+			provider: fmt.Sprintf(`
+provider "commonfate" {
+  api_url        = %q
+  oidc_client_id = %q
+}
+`, s.URL, mockapi.ClientID),
+			expectError: regexp.MustCompile(`oidc_issuer must be set in the provider block`),
+		},
+		{
+			name: "client secret without client ID",
+			provider: `
+provider "commonfate" {
+  oidc_client_secret = "secret"
+}
+`,
+			expectError: regexp.MustCompile(`oidc_client_id must be set in the provider block`),
+		},
+		{
+			name: "invalid API URL",
+			provider: fmt.Sprintf(`
+provider "commonfate" {
+  api_url            = "commonfate.example.com"
+  oidc_issuer        = %q
+  oidc_client_id     = %q
+  oidc_client_secret = %q
+}
+`, s.URL, mockapi.ClientID, mockapi.ClientSecret),
+			expectError: regexp.MustCompile(`api_url must be an http or https URL`),
+		},
+		{
+			name: "context without file source",
+			provider: `
+provider "commonfate" {
+  context        = "mock"
+  config_sources = ["env"]
+}
+`,
+			expectError: regexp.MustCompile(`context is only used when 'file' is one of the config_sources`),
+		},
+		{
+			name: "unreachable issuer",
+			provider: fmt.Sprintf(`
+provider "commonfate" {
+  api_url            = %q
+  oidc_issuer        = %q
+  oidc_client_id     = %q
+  oidc_client_secret = %q
+}
+`, s.URL, s.URL+"/missing", mockapi.ClientID, mockapi.ClientSecret),
+			expectError: regexp.MustCompile(`(?s)Invalid OIDC Issuer.*oidc_issuer`),
+		},
+		{
+			name: "invalid client secret",
+			provider: fmt.Sprintf(`
+provider "commonfate" {
+  api_url            = %q
+  oidc_issuer        = %q
+  oidc_client_id     = %q
+  oidc_client_secret = "wrong-secret"
+}
+`, s.URL, s.URL, mockapi.ClientID),
+			expectError: regexp.MustCompile(`(?s)Invalid OIDC Client Credentials.*oidc_client_secret`),
+		},
+		{
+			name: "unreachable API",
+			provider: fmt.Sprintf(`
+provider "commonfate" {
+  api_url            = "http://127.0.0.1:1"
+  oidc_issuer        = %q
+  oidc_client_id     = %q
+  oidc_client_secret = %q
+
+  retry = {
+    max_attempts = 1
+  }
+}
+`, s.URL, mockapi.ClientID, mockapi.ClientSecret),
+			expectError: regexp.MustCompile(`(?s)Unable to Reach Common Fate API.*api_url`),
+		},
+		{
+			name: "skip credentials validation",
+			provider: fmt.Sprintf(`
+provider "commonfate" {
+  api_url            = %q
+  oidc_issuer        = %q
+  oidc_client_id     = %q
+  oidc_client_secret = %q
+
+  skip_credentials_validation = true
+}
+`, s.URL, s.URL, mockapi.ClientID, mockapi.ClientSecret),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			resource.ParallelTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config:      tt.provider + testWorkflowConfig,
+						ExpectError: tt.expectError,
+						Check:       resource.TestCheckResourceAttrSet("commonfate_access_workflow.test", "id"),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccProvider_DeploymentMismatch(t *testing.T) {
+	a := acctest.NewServer(t)
+	b := acctest.NewServer(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(a) + testWorkflowConfig,
+				Check:  resource.TestCheckResourceAttrSet("commonfate_access_workflow.test", "id"),
+			},
+			{
+				// pointing the provider at another deployment must not touch the resource.
+				Config:      acctest.ProviderConfig(b) + testWorkflowConfig,
+				ExpectError: regexp.MustCompile(`Common Fate Deployment Mismatch`),
+			},
+			{
+				// skipping credentials validation doesn't skip the deployment check.
+				Config:      acctest.ProviderConfigWith(b, skipCredentialsValidation) + testWorkflowConfig,
+				ExpectError: regexp.MustCompile(`Common Fate Deployment Mismatch`),
+			},
+			{
+				// rotating the Terraform OIDC client doesn't change which deployment the resource belongs to.
+				PreConfig: func() {
+					a.SetTerraformClientID("rotated-client")
+				},
+				Config: acctest.ProviderConfig(a) + testWorkflowConfig,
+				Check:  resource.TestCheckResourceAttrSet("commonfate_access_workflow.test", "id"),
+			},
+		},
+	})
+}
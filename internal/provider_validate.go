@@ -0,0 +1,228 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	config_client "github.com/common-fate/sdk/config"
+	configv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1"
+	accessworkflow_handler "github.com/common-fate/sdk/service/control/config/accessworkflow"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/oauth2"
+)
+
+var _ provider.ProviderWithValidateConfig = &CommonFateProvider{}
+
+// ValidateConfig checks the attributes which can be validated without calling the API,
+// so that mistakes are reported by 'terraform validate' rather than the first plan.
+func (p *CommonFateProvider) ValidateConfig(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var config CommonFateProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ConfigSources.IsNull() && !config.ConfigSources.IsUnknown() {
+		var names []types.String
+		resp.Diagnostics.Append(config.ConfigSources.ElementsAs(ctx, &names, false)...)
+
+		hasFile := false
+		for i, name := range names {
+			if name.IsUnknown() {
+				// the context may be loaded by a source which isn't known yet.
+				hasFile = true
+				continue
+			}
+			if name.ValueString() == "file" {
+				hasFile = true
+			}
+			if _, err := newConfigSources([]string{name.ValueString()}, ""); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("config_sources").AtListIndex(i), "Invalid Config Source", err.Error())
+			}
+		}
+
+		if !config.Context.IsNull() && !hasFile {
+			resp.Diagnostics.AddAttributeError(path.Root("context"), "Invalid Context", "context is only used when 'file' is one of the config_sources. Add 'file' to config_sources, or remove context.")
+		}
+	}
+
+	_, diags := config.Retry.policy(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	_, diags = parseDefaultTimeout(config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+}
+
+// parseDefaultTimeout parses the provider's default_timeout attribute,
+// returning providerdata.DefaultTimeout if it isn't set.
+func parseDefaultTimeout(v types.String) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		return providerdata.DefaultTimeout, diags
+	}
+
+	d, err := time.ParseDuration(v.ValueString())
+	if err != nil || d <= 0 {
+		diags.AddAttributeError(path.Root("default_timeout"), "Invalid Default Timeout", fmt.Sprintf("default_timeout must be a positive duration such as '30s' or '5m', got %q.", v.ValueString()))
+	}
+	return d, diags
+}
+
+// urlValidator checks that a string attribute is an absolute http or https URL.
+type urlValidator struct{}
+
+func (v urlValidator) Description(_ context.Context) string {
+	return "value must be an absolute http or https URL"
+}
+
+func (v urlValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v urlValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := checkURL(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid URL", fmt.Sprintf("%s %s.", req.Path, err))
+	}
+}
+
+// checkURL returns an error describing why s isn't an absolute http or https URL.
+func checkURL(s string) error {
+	u, err := url.Parse(s)
+	if err != nil {
+		return fmt.Errorf("must be a valid URL, got %q", s)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("must be an http or https URL, such as 'https://commonfate.example.com', got %q", s)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("must include a host, got %q", s)
+	}
+	return nil
+}
+
+// checkLoadedOpts checks the values which were loaded from the provider block and config sources.
+// Values which came from a config source are reported against the attribute which would override them.
+func checkLoadedOpts(opts config_client.Opts) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	urls := []struct {
+		attr     string
+		env      string
+		value    string
+		required bool
+	}{
+		{"api_url", "CF_API_URL", opts.APIURL, true},
+		{"authz_url", "CF_AUTHZ_URL", opts.AuthzURL, false},
+		{"oidc_issuer", "CF_OIDC_ISSUER", opts.OIDCIssuer, true},
+	}
+
+	for _, u := range urls {
+		if u.value == "" {
+			if u.required {
+				diags.AddAttributeError(path.Root(u.attr), "Missing Provider Configuration", fmt.Sprintf("%s must be set in the provider block, the %s environment variable, or one of the provider's config_sources.", u.attr, u.env))
+			}
+			continue
+		}
+		if err := checkURL(u.value); err != nil {
+			diags.AddAttributeError(path.Root(u.attr), "Invalid URL", fmt.Sprintf("%s %s.", u.attr, err))
+		}
+	}
+
+	if opts.ClientID == "" {
+		diags.AddAttributeError(path.Root("oidc_client_id"), "Missing Provider Configuration", "oidc_client_id must be set in the provider block, the CF_OIDC_CLIENT_ID environment variable, or one of the provider's config_sources.")
+	}
+
+	return diags
+}
+
+// checkIssuer fetches the OIDC discovery document of the issuer, so that an
+// unreachable or misspelled issuer is reported against the oidc_issuer attribute.
+func checkIssuer(ctx context.Context, client *http.Client, issuer string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		diags.AddAttributeError(path.Root("oidc_issuer"), "Invalid OIDC Issuer", err.Error())
+		return diags
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		diags.AddAttributeError(path.Root("oidc_issuer"), "Unable to Reach OIDC Issuer", fmt.Sprintf("Could not fetch the OpenID configuration of %s: %s", issuer, err))
+		return diags
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		diags.AddAttributeError(path.Root("oidc_issuer"), "Invalid OIDC Issuer", fmt.Sprintf("Fetching the OpenID configuration of %s returned HTTP %d. Check that oidc_issuer is the issuer URL of your Common Fate deployment.", issuer, res.StatusCode))
+	}
+	return diags
+}
+
+// configError converts an error from config_client.New into diagnostics,
+// pointing at the client credentials when the token endpoint rejected them.
+func configError(err error, opts config_client.Opts) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if re := (&oauth2.RetrieveError{}); errors.As(err, &re) && rejectedCredentials(re) {
+		attr := path.Root("oidc_client_secret")
+		if opts.ClientSecret == "" {
+			attr = path.Root("oidc_client_id")
+		}
+		diags.AddAttributeError(attr, "Invalid OIDC Client Credentials", fmt.Sprintf("The OIDC issuer %s rejected the client credentials for client %q. Check oidc_client_id and oidc_client_secret.\n\n%s", opts.OIDCIssuer, opts.ClientID, err))
+		return diags
+	}
+
+	diags.AddError("Failed to load config", err.Error())
+	return diags
+}
+
+// rejectedCredentials reports whether the token endpoint rejected the client ID or secret,
+// rather than failing for some other reason such as an invalid scope.
+func rejectedCredentials(re *oauth2.RetrieveError) bool {
+	if re.ErrorCode != "" {
+		return slices.Contains([]string{"invalid_client", "unauthorized_client"}, re.ErrorCode)
+	}
+	return re.Response != nil && re.Response.StatusCode == http.StatusUnauthorized
+}
+
+// checkAPI makes a cheap authenticated call to the Common Fate API, so that an unreachable
+// API or rejected credentials are reported when the provider is configured, rather than
+// by whichever resource happens to call the API first.
+func checkAPI(ctx context.Context, cfg *config_client.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// looking up a workflow which doesn't exist is authenticated, but doesn't depend on what's been deployed.
+	client := accessworkflow_handler.NewFromConfig(cfg)
+	_, err := client.GetAccessWorkflow(ctx, connect.NewRequest(&configv1alpha1.GetAccessWorkflowRequest{
+		Id: "terraform-provider-configure-check",
+	}))
+
+	switch connect.CodeOf(err) {
+	case connect.CodeUnauthenticated:
+		diags.AddAttributeError(path.Root("oidc_client_id"), "Invalid OIDC Client Credentials", fmt.Sprintf("The Common Fate API at %s rejected the access token issued for client %q. Check that oidc_client_id belongs to this deployment.\n\n%s", cfg.APIURL, cfg.OIDCClientID, err))
+	case connect.CodePermissionDenied:
+		diags.AddAttributeWarning(path.Root("oidc_client_id"), "Insufficient Permissions", fmt.Sprintf("The Common Fate API at %s denied access to client %q, so creating or reading resources may fail.\n\n%s", cfg.APIURL, cfg.OIDCClientID, err))
+	case connect.CodeUnavailable, connect.CodeDeadlineExceeded, connect.CodeUnimplemented:
+		// unimplemented means the server isn't a Common Fate API, e.g. the URL of the web app was used instead.
+		diags.AddAttributeError(path.Root("api_url"), "Unable to Reach Common Fate API", fmt.Sprintf("Could not call the Common Fate API at %s. Check that api_url is the API URL of your Common Fate deployment.\n\n%s", cfg.APIURL, err))
+	}
+
+	return diags
+}
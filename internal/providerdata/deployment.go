@@ -0,0 +1,79 @@
+package providerdata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// deploymentKey is the private state key which records the deployment a resource belongs to.
+const deploymentKey = "deployment"
+
+// Deployment identifies the Common Fate deployment which the provider is configured for.
+//
+// Resources record the deployment in their private state when they are created, read or updated,
+// and refuse to change a resource which was recorded against a different deployment.
+// This stops a resource from being applied with the wrong provider alias
+// when several deployments are managed from one configuration.
+type Deployment struct {
+	// Fingerprint is derived from the deployment's config. It is empty if the
+	// config couldn't be fetched, in which case the checks are skipped.
+	Fingerprint string
+	// APIURL is the API URL which the provider is configured with.
+	APIURL string
+}
+
+// privateState is implemented by the private state of resource requests and responses.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+type recordedDeployment struct {
+	Fingerprint string `json:"fingerprint"`
+	APIURL      string `json:"api_url"`
+}
+
+// Record stores the deployment in the resource's private state.
+func (d Deployment) Record(ctx context.Context, private privateState) diag.Diagnostics {
+	if d.Fingerprint == "" {
+		return nil
+	}
+
+	value, err := json.Marshal(recordedDeployment{Fingerprint: d.Fingerprint, APIURL: d.APIURL})
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Failed to record Common Fate deployment", err.Error())
+		return diags
+	}
+
+	return private.SetKey(ctx, deploymentKey, value)
+}
+
+// Check returns an error if the resource's private state records a different deployment.
+// Resources created before deployments were recorded pass the check.
+func (d Deployment) Check(ctx context.Context, private privateState) diag.Diagnostics {
+	value, diags := private.GetKey(ctx, deploymentKey)
+	if diags.HasError() || value == nil || d.Fingerprint == "" {
+		return diags
+	}
+
+	var recorded recordedDeployment
+	if err := json.Unmarshal(value, &recorded); err != nil {
+		diags.AddError("Failed to read recorded Common Fate deployment", err.Error())
+		return diags
+	}
+
+	if recorded.Fingerprint != d.Fingerprint {
+		diags.AddError(
+			"Common Fate Deployment Mismatch",
+			fmt.Sprintf("This resource belongs to the Common Fate deployment at %s, but the provider is configured for a different deployment at %s. "+
+				"Check that the resource uses the right provider alias. If the resource really has moved to this deployment, remove it from state with 'terraform state rm' and import it again.",
+				recorded.APIURL, d.APIURL),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,24 @@
+// Package providerdata defines the data which the provider passes to its
+// resources and data sources when they are configured.
+package providerdata
+
+import (
+	"time"
+
+	config_client "github.com/common-fate/sdk/config"
+)
+
+// DefaultTimeout is how long a resource operation may take when neither the
+// resource's timeouts block nor the provider's default_timeout is set.
+const DefaultTimeout = 10 * time.Minute
+
+// Data is the ProviderData of every resource and data source.
+type Data struct {
+	// Config is used to build the Common Fate API clients.
+	Config *config_client.Context
+	// DefaultTimeout is how long a resource operation may take
+	// when the resource's timeouts block doesn't say otherwise.
+	DefaultTimeout time.Duration
+	// Deployment identifies the deployment which the provider is configured for.
+	Deployment Deployment
+}
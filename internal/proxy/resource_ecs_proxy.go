@@ -3,13 +3,15 @@ package proxy
 import (
 	"context"
 	"fmt"
+	"time"
 
 	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
 
-	config_client "github.com/common-fate/sdk/config"
 	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
 
 	"connectrpc.com/connect"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,17 +23,20 @@ type ECSProxyModel struct {
 	AwsRegion    types.String `tfsdk:"aws_region"`
 	AwsAccountID types.String `tfsdk:"aws_account_id"`
 
-	ECSClusterName              types.String `tfsdk:"ecs_cluster_name"`
-	ECSTaskDefinitionFamily     types.String `tfsdk:"ecs_task_definition_family"`
-	ECSClusterReaderRoleARN     types.String `tfsdk:"ecs_cluster_reader_role_arn"`
-	ECSClusterSecurityGroupID   types.String `tfsdk:"ecs_cluster_security_group_id"`
-	ECSClusterTaskRoleName      types.String `tfsdk:"ecs_cluster_task_role_name"`
-	ECSClusterTaskContainerName types.String `tfsdk:"ecs_cluster_task_container_name"`
+	ECSClusterName              types.String   `tfsdk:"ecs_cluster_name"`
+	ECSTaskDefinitionFamily     types.String   `tfsdk:"ecs_task_definition_family"`
+	ECSClusterReaderRoleARN     types.String   `tfsdk:"ecs_cluster_reader_role_arn"`
+	ECSClusterSecurityGroupID   types.String   `tfsdk:"ecs_cluster_security_group_id"`
+	ECSClusterTaskRoleName      types.String   `tfsdk:"ecs_cluster_task_role_name"`
+	ECSClusterTaskContainerName types.String   `tfsdk:"ecs_cluster_task_container_name"`
+	Timeouts                    timeouts.Value `tfsdk:"timeouts"`
 }
 
 // AccessRuleResource is the data source implementation.
 type ECSProxyResource struct {
-	client integrationv1alpha1connect.ProxyServiceClient
+	client         integrationv1alpha1connect.ProxyServiceClient
+	defaultTimeout time.Duration
+	deployment     providerdata.Deployment
 }
 
 var (
@@ -51,15 +56,18 @@ func (r *ECSProxyResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	cfg, ok := req.ProviderData.(*config_client.Context)
+	providerData, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
+	cfg := providerData.Config
+	r.defaultTimeout = providerData.DefaultTimeout
+	r.deployment = providerData.Deployment
 	client := integrationv1alpha1connect.NewProxyServiceClient(cfg.HTTPClient, cfg.APIURL)
 
 	r.client = client
@@ -111,6 +119,14 @@ func (r *ECSProxyResource) Schema(ctx context.Context, req resource.SchemaReques
 			},
 		},
 		MarkdownDescription: `Registers a proxy with Common Fate..`,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -138,6 +154,15 @@ func (r *ECSProxyResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	createReq := integrationv1alpha1.CreateProxyRequest{
 		Id: data.ID.ValueString(),
 		InstanceConfig: &integrationv1alpha1.CreateProxyRequest_AwsEcsProxyInstanceConfig{
@@ -173,6 +198,7 @@ func (r *ECSProxyResource) Create(ctx context.Context, req resource.CreateReques
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -189,6 +215,23 @@ func (r *ECSProxyResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// read the state from the client
 	res, err := r.client.GetProxy(ctx, connect.NewRequest(&integrationv1alpha1.GetProxyRequest{
@@ -207,9 +250,27 @@ func (r *ECSProxyResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	// refresh state
 
+	config := res.Msg.GetAwsEcsProxyInstanceConfig()
+	if config == nil {
+		resp.Diagnostics.AddError(
+			"Returned proxy did not contain any AWS ECS configuration",
+			"",
+		)
+		return
+	}
+
 	state.ID = types.StringValue(res.Msg.Id)
+	state.AwsRegion = types.StringValue(config.Region)
+	state.AwsAccountID = types.StringValue(config.Account)
+	state.ECSClusterName = types.StringValue(config.EcsClusterName)
+	state.ECSTaskDefinitionFamily = types.StringValue(config.EcsTaskDefinitionFamily)
+	state.ECSClusterReaderRoleARN = types.StringValue(config.EcsClusterReaderRoleArn)
+	state.ECSClusterSecurityGroupID = types.StringValue(config.EcsClusterSecurityGroupId)
+	state.ECSClusterTaskRoleName = types.StringValue(config.EcsClusterTaskRoleName)
+	state.ECSClusterTaskContainerName = types.StringValue(config.EcsContainerName)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *ECSProxyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -235,6 +296,20 @@ func (r *ECSProxyResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updateReq := integrationv1alpha1.UpdateProxyRequest{
 		Id: data.ID.ValueString(),
 		InstanceConfig: &integrationv1alpha1.UpdateProxyRequest_AwsEcsProxyInstanceConfig{
@@ -242,6 +317,7 @@ func (r *ECSProxyResource) Update(ctx context.Context, req resource.UpdateReques
 				EcsClusterName:            data.ECSClusterName.ValueString(),
 				Account:                   data.AwsAccountID.ValueString(),
 				Region:                    data.AwsRegion.ValueString(),
+				EcsTaskDefinitionFamily:   data.ECSTaskDefinitionFamily.ValueString(),
 				EcsContainerName:          data.ECSClusterTaskContainerName.ValueString(),
 				EcsClusterReaderRoleArn:   data.ECSClusterReaderRoleARN.ValueString(),
 				EcsClusterSecurityGroupId: data.ECSClusterSecurityGroupID.ValueString(),
@@ -269,6 +345,7 @@ func (r *ECSProxyResource) Update(ctx context.Context, req resource.UpdateReques
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *ECSProxyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -291,6 +368,20 @@ func (r *ECSProxyResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.DeleteProxy(ctx, connect.NewRequest(&integrationv1alpha1.DeleteProxyRequest{
 		Id: data.ID.ValueString(),
 	}))
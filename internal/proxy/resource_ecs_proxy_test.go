@@ -0,0 +1,57 @@
+package proxy_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccECSProxy(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_ecs_proxy.test",
+			Config: `
+resource "commonfate_ecs_proxy" "test" {
+  id                              = "test"
+  aws_account_id                  = "123456789012"
+  aws_region                      = "us-east-1"
+  ecs_cluster_name                = "proxy"
+  ecs_cluster_reader_role_arn     = "arn:aws:iam::123456789012:role/reader"
+  ecs_cluster_security_group_id   = "sg-123"
+  ecs_cluster_task_container_name = "proxy"
+  ecs_cluster_task_role_name      = "proxy-task"
+  ecs_task_definition_family      = "proxy"
+}
+`,
+			Check: map[string]string{
+				"id":                              "test",
+				"aws_account_id":                  "123456789012",
+				"aws_region":                      "us-east-1",
+				"ecs_cluster_name":                "proxy",
+				"ecs_cluster_reader_role_arn":     "arn:aws:iam::123456789012:role/reader",
+				"ecs_cluster_security_group_id":   "sg-123",
+				"ecs_cluster_task_container_name": "proxy",
+				"ecs_cluster_task_role_name":      "proxy-task",
+				"ecs_task_definition_family":      "proxy",
+			},
+			UpdatedConfig: `
+resource "commonfate_ecs_proxy" "test" {
+  id                              = "test"
+  aws_account_id                  = "123456789012"
+  aws_region                      = "us-east-1"
+  ecs_cluster_name                = "proxy-updated"
+  ecs_cluster_reader_role_arn     = "arn:aws:iam::123456789012:role/reader"
+  ecs_cluster_security_group_id   = "sg-456"
+  ecs_cluster_task_container_name = "proxy"
+  ecs_cluster_task_role_name      = "proxy-task"
+  ecs_task_definition_family      = "proxy"
+}
+`,
+			UpdatedCheck: map[string]string{
+				"ecs_cluster_name":              "proxy-updated",
+				"ecs_cluster_security_group_id": "sg-456",
+			},
+		},
+	})
+}
@@ -3,13 +3,15 @@ package proxy
 import (
 	"context"
 	"fmt"
+	"time"
 
 	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
 
-	config_client "github.com/common-fate/sdk/config"
 	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
 
 	"connectrpc.com/connect"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,17 +21,20 @@ import (
 )
 
 type EKSClusterModel struct {
-	ID                    types.String `tfsdk:"id"`
-	Name                  types.String `tfsdk:"name"`
-	Region                types.String `tfsdk:"region"`
-	AWSAccountID          types.String `tfsdk:"aws_account_id"`
-	ProxyId               types.String `tfsdk:"proxy_id"`
-	ClusterName           types.String `tfsdk:"cluster_name"`
-	ClusterAccessRoleName types.String `tfsdk:"cluster_access_role_name"`
+	ID                    types.String   `tfsdk:"id"`
+	Name                  types.String   `tfsdk:"name"`
+	Region                types.String   `tfsdk:"region"`
+	AWSAccountID          types.String   `tfsdk:"aws_account_id"`
+	ProxyId               types.String   `tfsdk:"proxy_id"`
+	ClusterName           types.String   `tfsdk:"cluster_name"`
+	ClusterAccessRoleName types.String   `tfsdk:"cluster_access_role_name"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
 }
 
 type EKSClusterResource struct {
-	client integrationv1alpha1connect.ProxyServiceClient
+	client         integrationv1alpha1connect.ProxyServiceClient
+	defaultTimeout time.Duration
+	deployment     providerdata.Deployment
 }
 
 var (
@@ -49,15 +54,18 @@ func (r *EKSClusterResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	cfg, ok := req.ProviderData.(*config_client.Context)
+	providerData, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
+	cfg := providerData.Config
+	r.defaultTimeout = providerData.DefaultTimeout
+	r.deployment = providerData.Deployment
 	client := integrationv1alpha1connect.NewProxyServiceClient(cfg.HTTPClient, cfg.APIURL)
 
 	r.client = client
@@ -107,6 +115,14 @@ func (r *EKSClusterResource) Schema(ctx context.Context, req resource.SchemaRequ
 			},
 		},
 		MarkdownDescription: `Registers a EKS Cluster with a Common Fate Proxy.`,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -134,6 +150,15 @@ func (r *EKSClusterResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	resource := &integrationv1alpha1.AWSEKSCluster{
 		Name:                  data.Name.ValueString(),
 		Region:                data.Region.ValueString(),
@@ -166,6 +191,7 @@ func (r *EKSClusterResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -182,6 +208,23 @@ func (r *EKSClusterResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// read the state from the client
 	res, err := r.client.GetProxyEksClusterResource(ctx, connect.NewRequest(&integrationv1alpha1.GetProxyEksClusterResourceRequest{
@@ -201,8 +244,14 @@ func (r *EKSClusterResource) Read(ctx context.Context, req resource.ReadRequest,
 	// refresh state
 
 	state.ID = types.StringValue(res.Msg.Id)
+	state.Name = types.StringValue(res.Msg.EksCluster.GetName())
+	state.Region = types.StringValue(res.Msg.EksCluster.GetRegion())
+	state.AWSAccountID = types.StringValue(res.Msg.EksCluster.GetAccount())
+	state.ClusterName = types.StringValue(res.Msg.EksCluster.GetClusterName())
+	state.ClusterAccessRoleName = types.StringPointerValue(res.Msg.EksCluster.ClusterAccessRoleName)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *EKSClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -228,6 +277,20 @@ func (r *EKSClusterResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resource := &integrationv1alpha1.AWSEKSCluster{
 		Name:                  data.Name.ValueString(),
 		Region:                data.Region.ValueString(),
@@ -261,6 +324,7 @@ func (r *EKSClusterResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *EKSClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -283,6 +347,20 @@ func (r *EKSClusterResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.DeleteProxyEksClusterResource(ctx, connect.NewRequest(&integrationv1alpha1.DeleteProxyEksClusterResourceRequest{
 		Id: data.ID.ValueString(),
 	}))
@@ -0,0 +1,48 @@
+package proxy_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccEKSCluster(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_proxy_eks_cluster.test",
+			Config: `
+resource "commonfate_proxy_eks_cluster" "test" {
+  proxy_id       = "proxy_123"
+  name           = "test"
+  region         = "us-east-1"
+  aws_account_id = "123456789012"
+  cluster_name   = "cluster"
+}
+`,
+			Check: map[string]string{
+				"proxy_id":       "proxy_123",
+				"name":           "test",
+				"region":         "us-east-1",
+				"aws_account_id": "123456789012",
+				"cluster_name":   "cluster",
+			},
+			UpdatedConfig: `
+resource "commonfate_proxy_eks_cluster" "test" {
+  proxy_id                 = "proxy_123"
+  name                     = "test-updated"
+  region                   = "us-east-1"
+  aws_account_id           = "123456789012"
+  cluster_name             = "cluster"
+  cluster_access_role_name = "access"
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name":                     "test-updated",
+				"cluster_access_role_name": "access",
+			},
+			// the API doesn't return the proxy a cluster belongs to.
+			ImportStateVerifyIgnore: []string{"proxy_id"},
+		},
+	})
+}
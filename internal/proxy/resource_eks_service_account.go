@@ -3,13 +3,15 @@ package proxy
 import (
 	"context"
 	"fmt"
+	"time"
 
 	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
 
-	config_client "github.com/common-fate/sdk/config"
 	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
 
 	"connectrpc.com/connect"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,13 +21,16 @@ import (
 )
 
 type EKSServiceAccountModel struct {
-	ID                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	ServiceAccountName types.String `tfsdk:"service_account_name"`
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	ServiceAccountName types.String   `tfsdk:"service_account_name"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 type EKSServiceAccountResource struct {
-	client integrationv1alpha1connect.ProxyServiceClient
+	client         integrationv1alpha1connect.ProxyServiceClient
+	defaultTimeout time.Duration
+	deployment     providerdata.Deployment
 }
 
 var (
@@ -45,15 +50,18 @@ func (r *EKSServiceAccountResource) Configure(_ context.Context, req resource.Co
 		return
 	}
 
-	cfg, ok := req.ProviderData.(*config_client.Context)
+	providerData, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
+	cfg := providerData.Config
+	r.defaultTimeout = providerData.DefaultTimeout
+	r.deployment = providerData.Deployment
 	client := integrationv1alpha1connect.NewProxyServiceClient(cfg.HTTPClient, cfg.APIURL)
 
 	r.client = client
@@ -84,6 +92,14 @@ func (r *EKSServiceAccountResource) Schema(ctx context.Context, req resource.Sch
 			},
 		},
 		MarkdownDescription: `Registers a EKS Service Account with a Common Fate Proxy.`,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -111,6 +127,15 @@ func (r *EKSServiceAccountResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	resource := &integrationv1alpha1.AWSEKSServiceAccount{
 		Name:               data.Name.ValueString(),
 		ServiceAccountName: data.ServiceAccountName.ValueString(),
@@ -139,6 +164,7 @@ func (r *EKSServiceAccountResource) Create(ctx context.Context, req resource.Cre
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -155,6 +181,23 @@ func (r *EKSServiceAccountResource) Read(ctx context.Context, req resource.ReadR
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// read the state from the client
 	res, err := r.client.GetProxyEksServiceAccountResource(ctx, connect.NewRequest(&integrationv1alpha1.GetProxyEksServiceAccountResourceRequest{
@@ -174,8 +217,11 @@ func (r *EKSServiceAccountResource) Read(ctx context.Context, req resource.ReadR
 	// refresh state
 
 	state.ID = types.StringValue(res.Msg.Id)
+	state.Name = types.StringValue(res.Msg.ServiceAccount.GetName())
+	state.ServiceAccountName = types.StringValue(res.Msg.ServiceAccount.GetServiceAccountName())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *EKSServiceAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -201,6 +247,20 @@ func (r *EKSServiceAccountResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resource := &integrationv1alpha1.AWSEKSServiceAccount{
 		Name:               data.Name.ValueString(),
 		ServiceAccountName: data.ServiceAccountName.ValueString(),
@@ -230,6 +290,7 @@ func (r *EKSServiceAccountResource) Update(ctx context.Context, req resource.Upd
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *EKSServiceAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -252,6 +313,20 @@ func (r *EKSServiceAccountResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.DeleteProxyEksServiceAccountResource(ctx, connect.NewRequest(&integrationv1alpha1.DeleteProxyEksServiceAccountResourceRequest{
 		Id: data.ID.ValueString(),
 	}))
@@ -0,0 +1,35 @@
+package proxy_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccEKSServiceAccount(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_proxy_eks_service_account.test",
+			Config: `
+resource "commonfate_proxy_eks_service_account" "test" {
+  name                 = "test"
+  service_account_name = "reader"
+}
+`,
+			Check: map[string]string{
+				"name":                 "test",
+				"service_account_name": "reader",
+			},
+			UpdatedConfig: `
+resource "commonfate_proxy_eks_service_account" "test" {
+  name                 = "test-updated"
+  service_account_name = "reader"
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name": "test-updated",
+			},
+		},
+	})
+}
@@ -3,13 +3,15 @@ package proxy
 import (
 	"context"
 	"fmt"
+	"time"
 
 	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
 
-	config_client "github.com/common-fate/sdk/config"
 	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
 
 	"connectrpc.com/connect"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -30,7 +32,8 @@ type RDSDatabaseModel struct {
 	Database     types.String `tfsdk:"database"`
 	ProxyId      types.String `tfsdk:"proxy_id"`
 
-	Users []DatabaseUser `tfsdk:"users"`
+	Users    []DatabaseUser `tfsdk:"users"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type Database struct {
@@ -46,7 +49,9 @@ type DatabaseUser struct {
 
 // AccessRuleResource is the data source implementation.
 type RDSDatabaseResource struct {
-	client integrationv1alpha1connect.ProxyServiceClient
+	client         integrationv1alpha1connect.ProxyServiceClient
+	defaultTimeout time.Duration
+	deployment     providerdata.Deployment
 }
 
 var (
@@ -66,15 +71,18 @@ func (r *RDSDatabaseResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	cfg, ok := req.ProviderData.(*config_client.Context)
+	providerData, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
+	cfg := providerData.Config
+	r.defaultTimeout = providerData.DefaultTimeout
+	r.deployment = providerData.Deployment
 	client := integrationv1alpha1connect.NewProxyServiceClient(cfg.HTTPClient, cfg.APIURL)
 
 	r.client = client
@@ -161,6 +169,14 @@ func (r *RDSDatabaseResource) Schema(ctx context.Context, req resource.SchemaReq
 			},
 		},
 		MarkdownDescription: `Registers a RDS database with a Common Fate Proxy.`,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -188,6 +204,15 @@ func (r *RDSDatabaseResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	resource := &integrationv1alpha1.AWSRDSDatabase{
 		Name:       data.Name.ValueString(),
 		Engine:     data.Engine.ValueString(),
@@ -238,6 +263,7 @@ func (r *RDSDatabaseResource) Create(ctx context.Context, req resource.CreateReq
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -254,6 +280,23 @@ func (r *RDSDatabaseResource) Read(ctx context.Context, req resource.ReadRequest
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// read the state from the client
 	res, err := r.client.GetProxyRdsResource(ctx, connect.NewRequest(&integrationv1alpha1.GetProxyRdsResourceRequest{
@@ -272,6 +315,7 @@ func (r *RDSDatabaseResource) Read(ctx context.Context, req resource.ReadRequest
 
 	// refresh state
 	state = RDSDatabaseModel{
+		Timeouts:     state.Timeouts,
 		ID:           types.StringValue(res.Msg.Id),
 		InstanceID:   types.StringValue(res.Msg.RdsDatabase.InstanceId),
 		Name:         types.StringValue(res.Msg.RdsDatabase.Name),
@@ -292,6 +336,7 @@ func (r *RDSDatabaseResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *RDSDatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -317,6 +362,20 @@ func (r *RDSDatabaseResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resource := &integrationv1alpha1.AWSRDSDatabase{
 
 		Name:       data.Name.ValueString(),
@@ -369,6 +428,7 @@ func (r *RDSDatabaseResource) Update(ctx context.Context, req resource.UpdateReq
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *RDSDatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -391,6 +451,20 @@ func (r *RDSDatabaseResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.DeleteProxyRdsResource(ctx, connect.NewRequest(&integrationv1alpha1.DeleteProxyRdsResourceRequest{
 		Id: data.ID.ValueString(),
 	}))
@@ -0,0 +1,72 @@
+package proxy_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccRDSDatabase(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_proxy_rds_database.test",
+			Config: `
+resource "commonfate_proxy_rds_database" "test" {
+  proxy_id       = "proxy_123"
+  name           = "test"
+  region         = "us-east-1"
+  aws_account_id = "123456789012"
+  instance_id    = "database-1"
+  engine         = "postgres"
+  database       = "app"
+  endpoint       = "database-1.abc123.us-east-1.rds.amazonaws.com:5432"
+  users = [
+    {
+      name                         = "read-only"
+      username                     = "readonly"
+      password_secrets_manager_arn = "arn:aws:secretsmanager:us-east-1:123456789012:secret:readonly"
+    },
+  ]
+}
+`,
+			Check: map[string]string{
+				"name":                       "test",
+				"engine":                     "postgres",
+				"users.#":                    "1",
+				"users.0.name":               "read-only",
+				"users.0.default_local_port": "0",
+			},
+			UpdatedConfig: `
+resource "commonfate_proxy_rds_database" "test" {
+  proxy_id       = "proxy_123"
+  name           = "test-updated"
+  region         = "us-east-1"
+  aws_account_id = "123456789012"
+  instance_id    = "database-1"
+  engine         = "postgres"
+  database       = "app"
+  endpoint       = "database-1.abc123.us-east-1.rds.amazonaws.com:5432"
+  users = [
+    {
+      name                         = "read-only"
+      username                     = "readonly"
+      password_secrets_manager_arn = "arn:aws:secretsmanager:us-east-1:123456789012:secret:readonly"
+    },
+    {
+      name                         = "admin"
+      username                     = "admin"
+      password_secrets_manager_arn = "arn:aws:secretsmanager:us-east-1:123456789012:secret:admin"
+      default_local_port           = 5433
+    },
+  ]
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name":                       "test-updated",
+				"users.#":                    "2",
+				"users.1.default_local_port": "5433",
+			},
+		},
+	})
+}
@@ -0,0 +1,250 @@
+// Package retry retries requests to the Common Fate API which fail with a transient error.
+package retry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maxDelay caps the delay between two attempts, however many attempts are made.
+const maxDelay = 30 * time.Second
+
+// Policy controls how failed requests are retried.
+type Policy struct {
+	// MaxAttempts is the maximum number of attempts made for each request,
+	// including the first one. Values less than 2 disable retries.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry. It doubles after each attempt.
+	BaseDelay time.Duration
+	// RetryableCodes are the error codes which cause a request to be retried.
+	RetryableCodes []connect.Code
+}
+
+// DefaultPolicy returns the policy used when the provider block doesn't configure retries.
+func DefaultPolicy() Policy {
+	return Policy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Second,
+		RetryableCodes: []connect.Code{
+			connect.CodeUnavailable,
+			connect.CodeResourceExhausted,
+			connect.CodeDeadlineExceeded,
+		},
+	}
+}
+
+// delay returns how long to wait after the given (1-indexed) attempt fails.
+func (p Policy) delay(attempt int) time.Duration {
+	d := p.BaseDelay
+	for i := 1; i < attempt && d < maxDelay; i++ {
+		d *= 2
+	}
+	return min(d, maxDelay)
+}
+
+func (p Policy) retryable(code connect.Code) bool {
+	for _, c := range p.RetryableCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Transport is a http.RoundTripper which retries connect-rpc and gRPC requests
+// according to Policy. Wrapping the HTTP client of a config_client.Context
+// applies the policy to every API client built from it.
+type Transport struct {
+	Base   http.RoundTripper
+	Policy Policy
+}
+
+// Wrap returns a copy of client whose requests are retried according to policy.
+func Wrap(client *http.Client, policy Policy) *http.Client {
+	c := *client
+	c.Transport = &Transport{Base: client.Transport, Policy: policy}
+	return &c
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base == nil {
+		return http.DefaultTransport
+	}
+	return t.Base
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// streaming requests can't be replayed, as their body isn't known up front.
+	if t.Policy.MaxAttempts < 2 || strings.HasPrefix(req.Header.Get("Content-Type"), "application/connect+") {
+		return t.base().RoundTrip(req)
+	}
+
+	var body []byte
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+
+	ctx := req.Context()
+
+	for attempt := 1; ; attempt++ {
+		attemptReq := req.Clone(ctx)
+		if body != nil {
+			attemptReq.Body = io.NopCloser(bytes.NewReader(body))
+			attemptReq.GetBody = func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(body)), nil
+			}
+			attemptReq.ContentLength = int64(len(body))
+		}
+
+		res, err := t.base().RoundTrip(attemptReq)
+
+		code, failed := errorCode(res, err)
+		if !failed || !t.Policy.retryable(code) || attempt >= t.Policy.MaxAttempts || ctx.Err() != nil {
+			return res, err
+		}
+		// a request which may have been processed is only replayed if doing so can't change the outcome,
+		// so that a create which timed out waiting for its response doesn't create a duplicate.
+		if !idempotent(req.URL.Path) && !notProcessed(code, res, err) {
+			return res, err
+		}
+
+		if res != nil {
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		delay := t.Policy.delay(attempt)
+
+		tflog.Warn(ctx, "Retrying Common Fate API request after a transient error", map[string]any{
+			"procedure":    req.URL.Path,
+			"code":         code.String(),
+			"attempt":      attempt,
+			"max_attempts": t.Policy.MaxAttempts,
+			"delay":        delay.String(),
+		})
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// idempotentMethods are the prefixes of the API methods which can be replayed without changing their outcome.
+// Updates replace the whole object, so replaying one leaves it in the same state.
+var idempotentMethods = []string{"Get", "List", "Query", "Select", "Test", "Validate", "Update", "Delete", "BatchGet", "BatchAuthorize"}
+
+// idempotent reports whether the procedure at path, such as /commonfate.control.config.v1alpha1.AccessWorkflowService/GetAccessWorkflow,
+// can be replayed. Methods which aren't known to be idempotent, such as creates, are assumed not to be.
+func idempotent(path string) bool {
+	method := path[strings.LastIndex(path, "/")+1:]
+	for _, prefix := range idempotentMethods {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// notProcessed reports whether a failed request is known not to have been processed by the API:
+// either the connection couldn't be made, or the API responded that it was unavailable or overloaded.
+// Network errors after the request was sent, timeouts and gateway errors are ambiguous, as the
+// API may have processed the request before the response was lost.
+func notProcessed(code connect.Code, res *http.Response, err error) bool {
+	if err != nil {
+		var opErr *net.OpError
+		var dnsErr *net.DNSError
+		return (errors.As(err, &opErr) && opErr.Op == "dial") || errors.As(err, &dnsErr)
+	}
+	if res.StatusCode == http.StatusBadGateway || res.StatusCode == http.StatusGatewayTimeout {
+		return false
+	}
+	return code == connect.CodeUnavailable || code == connect.CodeResourceExhausted
+}
+
+// errorCode returns the connect-rpc code of a failed request. The response body
+// is buffered when it needs to be inspected, so the response can still be returned to the caller.
+func errorCode(res *http.Response, err error) (connect.Code, bool) {
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return connect.CodeCanceled, true
+		}
+		if errors.Is(err, context.DeadlineExceeded) {
+			return connect.CodeDeadlineExceeded, true
+		}
+		// connect-rpc reports network errors, such as a refused connection, as unavailable.
+		return connect.CodeUnavailable, true
+	}
+
+	// gRPC errors are sent with a 200 status, in a trailers-only response.
+	if s := res.Header.Get("Grpc-Status"); s != "" {
+		n, convErr := strconv.Atoi(s)
+		if convErr != nil || n == 0 {
+			return 0, false
+		}
+		return connect.Code(n), true
+	}
+
+	if res.StatusCode == http.StatusOK {
+		return 0, false
+	}
+
+	// connect-rpc unary errors are JSON objects containing the error code.
+	b, readErr := io.ReadAll(res.Body)
+	res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(b))
+	if readErr == nil {
+		var wire struct {
+			Code connect.Code `json:"code"`
+		}
+		if json.Unmarshal(b, &wire) == nil && wire.Code != 0 {
+			return wire.Code, true
+		}
+	}
+
+	// otherwise the error likely came from a load balancer or proxy in front of the API.
+	return httpStatusCode(res.StatusCode), true
+}
+
+// httpStatusCode maps a HTTP status to a connect-rpc code, following the connect-rpc protocol specification.
+func httpStatusCode(status int) connect.Code {
+	switch status {
+	case 400:
+		return connect.CodeInvalidArgument
+	case 401:
+		return connect.CodeUnauthenticated
+	case 403:
+		return connect.CodePermissionDenied
+	case 404:
+		return connect.CodeUnimplemented
+	case 408:
+		return connect.CodeDeadlineExceeded
+	case 412:
+		return connect.CodeFailedPrecondition
+	case 413, 431:
+		return connect.CodeResourceExhausted
+	case 429, 502, 503, 504:
+		return connect.CodeUnavailable
+	default:
+		return connect.CodeUnknown
+	}
+}
@@ -0,0 +1,205 @@
+package retry
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+func TestPolicy_Delay(t *testing.T) {
+	p := Policy{BaseDelay: time.Second}
+
+	for attempt, want := range map[int]time.Duration{
+		1:  time.Second,
+		2:  2 * time.Second,
+		3:  4 * time.Second,
+		10: maxDelay,
+	} {
+		if got := p.delay(attempt); got != want {
+			t.Errorf("delay(%d) = %s, want %s", attempt, got, want)
+		}
+	}
+}
+
+func TestTransport(t *testing.T) {
+	tests := []struct {
+		name         string
+		contentType  string
+		procedure    string
+		responses    []func(w http.ResponseWriter)
+		wantAttempts int
+		wantStatus   int
+		wantErr      bool
+	}{
+		{
+			name:         "connect error is retried",
+			contentType:  "application/proto",
+			responses:    []func(w http.ResponseWriter){connectError(503, "unavailable"), ok},
+			wantAttempts: 2,
+			wantStatus:   200,
+		},
+		{
+			name:         "gRPC error is retried",
+			contentType:  "application/grpc",
+			responses:    []func(w http.ResponseWriter){grpcError(connect.CodeResourceExhausted), ok},
+			wantAttempts: 2,
+			wantStatus:   200,
+		},
+		{
+			name:         "proxy error is retried",
+			contentType:  "application/proto",
+			responses:    []func(w http.ResponseWriter){status(502), ok},
+			wantAttempts: 2,
+			wantStatus:   200,
+		},
+		{
+			name:         "other codes are not retried",
+			contentType:  "application/proto",
+			responses:    []func(w http.ResponseWriter){connectError(404, "not_found"), ok},
+			wantAttempts: 1,
+			wantStatus:   404,
+		},
+		{
+			name:         "stops after max attempts",
+			contentType:  "application/proto",
+			responses:    []func(w http.ResponseWriter){status(503), status(503), status(503), ok},
+			wantAttempts: 3,
+			wantStatus:   503,
+		},
+		{
+			name:         "network error is retried for reads",
+			contentType:  "application/proto",
+			responses:    []func(w http.ResponseWriter){hangUp, ok},
+			wantAttempts: 2,
+			wantStatus:   200,
+		},
+		{
+			name:         "network error is not retried for creates",
+			contentType:  "application/proto",
+			procedure:    "/commonfate.control.config.v1alpha1.AccessWorkflowService/CreateAccessWorkflow",
+			responses:    []func(w http.ResponseWriter){hangUp, ok},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "timeout is not retried for creates",
+			contentType:  "application/proto",
+			procedure:    "/commonfate.control.config.v1alpha1.AccessWorkflowService/CreateAccessWorkflow",
+			responses:    []func(w http.ResponseWriter){status(408), ok},
+			wantAttempts: 1,
+			wantStatus:   408,
+		},
+		{
+			name:         "gateway error is not retried for creates",
+			contentType:  "application/proto",
+			procedure:    "/commonfate.control.config.v1alpha1.AccessWorkflowService/CreateAccessWorkflow",
+			responses:    []func(w http.ResponseWriter){status(504), ok},
+			wantAttempts: 1,
+			wantStatus:   504,
+		},
+		{
+			name:         "unavailable is retried for creates",
+			contentType:  "application/proto",
+			procedure:    "/commonfate.control.config.v1alpha1.AccessWorkflowService/CreateAccessWorkflow",
+			responses:    []func(w http.ResponseWriter){connectError(503, "unavailable"), ok},
+			wantAttempts: 2,
+			wantStatus:   200,
+		},
+		{
+			name:         "streaming requests are not retried",
+			contentType:  "application/connect+proto",
+			responses:    []func(w http.ResponseWriter){status(503), ok},
+			wantAttempts: 1,
+			wantStatus:   503,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// every attempt should carry the original request body.
+				if body, _ := io.ReadAll(r.Body); string(body) != "request" {
+					t.Errorf("attempt %d body = %q, want %q", attempts+1, body, "request")
+				}
+				tt.responses[attempts](w)
+				attempts++
+			}))
+			defer srv.Close()
+
+			policy := DefaultPolicy()
+			policy.BaseDelay = time.Millisecond
+			client := Wrap(srv.Client(), policy)
+
+			procedure := tt.procedure
+			if procedure == "" {
+				procedure = "/commonfate.control.config.v1alpha1.AccessWorkflowService/GetAccessWorkflow"
+			}
+			req, err := http.NewRequest(http.MethodPost, srv.URL+procedure, strings.NewReader("request"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Content-Type", tt.contentType)
+
+			res, err := client.Do(req)
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+			if tt.wantErr {
+				if err == nil {
+					res.Body.Close()
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			res.Body.Close()
+
+			if res.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", res.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func ok(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusOK)
+}
+
+// hangUp closes the connection without responding, as happens when the network fails after a request was sent.
+func hangUp(w http.ResponseWriter) {
+	conn, _, err := w.(http.Hijacker).Hijack()
+	if err != nil {
+		panic(err)
+	}
+	conn.Close()
+}
+
+func status(code int) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		w.WriteHeader(code)
+	}
+}
+
+func connectError(status int, code string) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = fmt.Fprintf(w, `{"code":%q,"message":"failed"}`, code)
+	}
+}
+
+func grpcError(code connect.Code) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		w.Header().Set("Grpc-Status", fmt.Sprint(int(code)))
+		w.WriteHeader(http.StatusOK)
+	}
+}
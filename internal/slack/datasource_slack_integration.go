@@ -0,0 +1,120 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+
+	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
+	"github.com/common-fate/sdk/service/control/integration"
+	"github.com/common-fate/terraform-provider-commonfate/internal/integrations"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SlackIntegrationDatasourceModel struct {
+	Id                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	ClientID                types.String `tfsdk:"client_id"`
+	ClientSecretSecretPath  types.String `tfsdk:"client_secret_secret_path"`
+	SigningSecretSecretPath types.String `tfsdk:"signing_secret_secret_path"`
+}
+
+// SlackIntegrationDatasource looks up an existing Slack integration by ID or name.
+type SlackIntegrationDatasource struct {
+	client integrationv1alpha1connect.IntegrationServiceClient
+}
+
+var (
+	_ datasource.DataSource                     = &SlackIntegrationDatasource{}
+	_ datasource.DataSourceWithConfigValidators = &SlackIntegrationDatasource{}
+)
+
+// Metadata returns the data source type name.
+func (r *SlackIntegrationDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_slack_integration"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *SlackIntegrationDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	cfg := providerData.Config
+
+	r.client = integration.NewFromConfig(cfg)
+}
+
+func (r *SlackIntegrationDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing Slack integration by ID or name.",
+		Attributes: integrations.LookupAttributes("Slack", map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The Slack application Client ID",
+				Computed:            true,
+			},
+			"client_secret_secret_path": schema.StringAttribute{
+				MarkdownDescription: "Path to secret for Client Secret",
+				Computed:            true,
+			},
+			"signing_secret_secret_path": schema.StringAttribute{
+				MarkdownDescription: "Path to secret for Signing Secret",
+				Computed:            true,
+			},
+		}),
+	}
+}
+
+func (r *SlackIntegrationDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return integrations.ConfigValidators()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *SlackIntegrationDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HTTP Client",
+			"Expected configured HTTP client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data SlackIntegrationDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integ, diags := integrations.Lookup(ctx, r.client, data.Id, data.Name, "Slack", func(c *integrationv1alpha1.Config) bool {
+		return c.GetSlack() != nil
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := integ.Config.GetSlack()
+
+	state := SlackIntegrationDatasourceModel{
+		Id:                      types.StringValue(integ.Id),
+		Name:                    types.StringValue(integ.Name),
+		ClientID:                types.StringValue(config.ClientId),
+		ClientSecretSecretPath:  types.StringValue(config.ClientSecretSecretPath),
+		SigningSecretSecretPath: types.StringValue(config.SigningSecretSecretPath),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
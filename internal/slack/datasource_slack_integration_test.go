@@ -0,0 +1,63 @@
+package slack_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+const slackIntegrationDatasourceResource = `
+resource "commonfate_slack_integration" "test" {
+  name                       = "test"
+  client_id                  = "client"
+  client_secret_secret_path  = "/secret"
+  signing_secret_secret_path = "/signing"
+}
+`
+
+func TestAccSlackIntegrationDatasource(t *testing.T) {
+	acctest.RunDatasourceTests(t, []acctest.DatasourceTest{
+		{
+			Name:       "by_id",
+			Datasource: "data.commonfate_slack_integration.test",
+			Config: slackIntegrationDatasourceResource + `
+data "commonfate_slack_integration" "test" {
+  id = commonfate_slack_integration.test.id
+}
+`,
+			Check: map[string]string{
+				"name":                       "test",
+				"client_id":                  "client",
+				"client_secret_secret_path":  "/secret",
+				"signing_secret_secret_path": "/signing",
+			},
+		},
+		{
+			Name:       "by_name",
+			Datasource: "data.commonfate_slack_integration.test",
+			Config: slackIntegrationDatasourceResource + `
+data "commonfate_slack_integration" "test" {
+  name = "test"
+
+  depends_on = [commonfate_slack_integration.test]
+}
+`,
+			Check: map[string]string{
+				"name":                       "test",
+				"client_id":                  "client",
+				"client_secret_secret_path":  "/secret",
+				"signing_secret_secret_path": "/signing",
+			},
+		},
+		{
+			Name: "not_found",
+			Config: `
+data "commonfate_slack_integration" "test" {
+  name = "missing"
+}
+`,
+			ExpectError: regexp.MustCompile(`Slack Integration Not Found`),
+		},
+	})
+}
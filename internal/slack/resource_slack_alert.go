@@ -7,10 +7,12 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/common-fate/grab"
-	config_client "github.com/common-fate/sdk/config"
 	configv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1"
 	configv1alpha1connect "github.com/common-fate/sdk/gen/commonfate/control/config/v1alpha1/configv1alpha1connect"
 	slack_alert_handler "github.com/common-fate/sdk/service/control/config/slackalert"
+	"github.com/common-fate/terraform-provider-commonfate/internal/duration"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,24 +20,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 type SlackAlertModel struct {
-	ID                             types.String `tfsdk:"id"`
-	WorkflowID                     types.String `tfsdk:"workflow_id"`
-	SlackIntegrationID             types.String `tfsdk:"integration_id"`
-	SlackChannelID                 types.String `tfsdk:"slack_channel_id"`
-	SlackWorkspaceID               types.String `tfsdk:"slack_workspace_id"`
-	UseWebConsoleForApprovalAction types.Bool   `tfsdk:"use_web_console_for_approval_action"`
-	SendDirectMessagesToApprovers  types.Bool   `tfsdk:"send_direct_message_to_approvers"`
-	DisableInteractivityHandlers   types.Bool   `tfsdk:"disable_interactivity_handlers"`
-	NotifyExpiryInSeconds          types.Int64  `tfsdk:"notify_expiry_in_seconds"`
+	ID                             types.String   `tfsdk:"id"`
+	WorkflowID                     types.String   `tfsdk:"workflow_id"`
+	SlackIntegrationID             types.String   `tfsdk:"integration_id"`
+	SlackChannelID                 types.String   `tfsdk:"slack_channel_id"`
+	SlackWorkspaceID               types.String   `tfsdk:"slack_workspace_id"`
+	UseWebConsoleForApprovalAction types.Bool     `tfsdk:"use_web_console_for_approval_action"`
+	SendDirectMessagesToApprovers  types.Bool     `tfsdk:"send_direct_message_to_approvers"`
+	DisableInteractivityHandlers   types.Bool     `tfsdk:"disable_interactivity_handlers"`
+	NotifyExpiryInSeconds          duration.Value `tfsdk:"notify_expiry_in_seconds"`
+	Timeouts                       timeouts.Value `tfsdk:"timeouts"`
 }
 
 // AccessRuleResource is the data source implementation.
 type SlackAlertResource struct {
-	client configv1alpha1connect.SlackAlertServiceClient
+	client         configv1alpha1connect.SlackAlertServiceClient
+	defaultTimeout time.Duration
+	deployment     providerdata.Deployment
 }
 
 var (
@@ -55,15 +59,18 @@ func (r *SlackAlertResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	cfg, ok := req.ProviderData.(*config_client.Context)
+	providerData, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
+	cfg := providerData.Config
+	r.defaultTimeout = providerData.DefaultTimeout
+	r.deployment = providerData.Deployment
 	client := slack_alert_handler.NewFromConfig(cfg)
 
 	r.client = client
@@ -117,12 +124,21 @@ func (r *SlackAlertResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
-			"notify_expiry_in_seconds": schema.Int64Attribute{
-				MarkdownDescription: "The duration before access expiration at which Slack will notify the user about the upcoming expiration.",
+			"notify_expiry_in_seconds": schema.StringAttribute{
+				MarkdownDescription: "The duration before access expiration at which Slack will notify the user about the upcoming expiration, e.g. `15m`. A number is read as a number of seconds.",
 				Optional:            true,
+				CustomType:          duration.Type{},
 			},
 		},
 		MarkdownDescription: `Links a Slack message being send to a particular channel or workspace based on actions made against a workflow.`,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -150,6 +166,15 @@ func (r *SlackAlertResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	if !data.SlackChannelID.IsNull() && data.SendDirectMessagesToApprovers.ValueBool() {
 		resp.Diagnostics.AddError(
 			"Unable to Create Resource",
@@ -184,10 +209,7 @@ func (r *SlackAlertResource) Create(ctx context.Context, req resource.CreateRequ
 		createSlackAlert.IntegrationId = data.SlackIntegrationID.ValueStringPointer()
 	}
 
-	if !data.NotifyExpiryInSeconds.IsNull() {
-		notifyExpiry := time.Second * time.Duration(data.NotifyExpiryInSeconds.ValueInt64())
-		createSlackAlert.NotifyExpiryInSeconds = durationpb.New(notifyExpiry)
-	}
+	createSlackAlert.NotifyExpiryInSeconds = data.NotifyExpiryInSeconds.ToAPI()
 	res, err := r.client.CreateSlackAlert(ctx, connect.NewRequest(createSlackAlert))
 
 	if err != nil {
@@ -207,6 +229,7 @@ func (r *SlackAlertResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -223,6 +246,23 @@ func (r *SlackAlertResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	//read the state from the client
 	res, err := r.client.GetSlackAlert(ctx, connect.NewRequest(&configv1alpha1.GetSlackAlertRequest{
@@ -241,6 +281,7 @@ func (r *SlackAlertResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	//refresh state
 	state = SlackAlertModel{
+		Timeouts:   state.Timeouts,
 		ID:         types.StringValue(res.Msg.Alert.Id),
 		WorkflowID: types.StringValue(res.Msg.Alert.WorkflowId),
 		// ensure that if channel ID is not provided that it doesn't show an update is going to happen
@@ -250,13 +291,11 @@ func (r *SlackAlertResource) Read(ctx context.Context, req resource.ReadRequest,
 		UseWebConsoleForApprovalAction: types.BoolPointerValue(&res.Msg.Alert.UseWebConsoleForApproveAction),
 		SendDirectMessagesToApprovers:  types.BoolPointerValue(&res.Msg.Alert.SendDirectMessagesToApprovers),
 		DisableInteractivityHandlers:   types.BoolPointerValue(&res.Msg.Alert.DisableInteractivityHandlers),
-	}
-
-	if res.Msg.Alert.NotifyExpiryInSeconds != nil {
-		state.NotifyExpiryInSeconds = types.Int64Value(res.Msg.Alert.NotifyExpiryInSeconds.Seconds)
+		NotifyExpiryInSeconds:          duration.FromAPI(res.Msg.Alert.NotifyExpiryInSeconds),
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *SlackAlertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -279,6 +318,20 @@ func (r *SlackAlertResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if !data.SlackChannelID.IsNull() && data.SendDirectMessagesToApprovers.ValueBool() {
 		resp.Diagnostics.AddError(
 			"Unable to Create Resource",
@@ -316,10 +369,7 @@ func (r *SlackAlertResource) Update(ctx context.Context, req resource.UpdateRequ
 		updateSlackAlert.Alert.IntegrationId = data.SlackIntegrationID.ValueStringPointer()
 	}
 
-	if !data.NotifyExpiryInSeconds.IsNull() {
-		notifyExpiry := time.Second * time.Duration(data.NotifyExpiryInSeconds.ValueInt64())
-		updateSlackAlert.Alert.NotifyExpiryInSeconds = durationpb.New(notifyExpiry)
-	}
+	updateSlackAlert.Alert.NotifyExpiryInSeconds = data.NotifyExpiryInSeconds.ToAPI()
 
 	res, err := r.client.UpdateSlackAlert(ctx, connect.NewRequest(updateSlackAlert))
 
@@ -337,6 +387,7 @@ func (r *SlackAlertResource) Update(ctx context.Context, req resource.UpdateRequ
 	data.ID = types.StringValue(res.Msg.Alert.Id)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.deployment.Record(ctx, resp.Private)...)
 }
 
 func (r *SlackAlertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -359,6 +410,20 @@ func (r *SlackAlertResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.deployment.Check(ctx, req.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//TODO: call api to remove the identity source
 	_, err := r.client.DeleteSlackAlert(ctx, connect.NewRequest(&configv1alpha1.DeleteSlackAlertRequest{
 		Id: data.ID.ValueString(),
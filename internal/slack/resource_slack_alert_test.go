@@ -1,59 +1,42 @@
-package slack
-
-// func TestAccSlackAlert(t *testing.T) {
-// 	resource.Test(t, resource.TestCase{
-// 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-// 		Steps: []resource.TestStep{
-// 			// Create and Read testing
-// 			{
-
-// 				Config: providerConfig + `
-
-// 				  resource "commonfate_slack_alert" "test" {
-// 					workflow_id   = "test"
-// 					slack_channel_id = "test"
-// 					slack_workspace_id = "test"
-// 				  }
-
-// `,
-// 				Check: resource.ComposeAggregateTestCheckFunc(
-
-// 					resource.TestCheckResourceAttr("commonfate_slack_alert.test", "workflow_id", "test"),
-// 					resource.TestCheckResourceAttr("commonfate_slack_alert.test", "slack_channel_id", "test"),
-// 					resource.TestCheckResourceAttr("commonfate_slack_alert.test", "slack_workspace_id", "test"),
-
-// 					// Verify dynamic values have any value set in the state.
-// 					resource.TestCheckResourceAttrSet("commonfate_slack_alert.test", "id"),
-// 				),
-// 			},
-// 			// ImportState testing
-// 			{
-// 				ResourceName:      "commonfate_slack_alert.test",
-// 				ImportState:       true,
-// 				ImportStateVerify: true,
-// 			},
-// 			// Update and Read testing
-// 			{
-// 				Config: providerConfig + `
-
-// 				  resource "commonfate_slack_alert" "test" {
-// 					workflow_id   = "test-updated"
-// 					slack_workspace_id = "test-updated"
-// 					slack_channel_id = "test-updated"
-// 				  }
-
-// 						`,
-// 				Check: resource.ComposeAggregateTestCheckFunc(
-// 					// Verify first order item updated
-// 					resource.TestCheckResourceAttr("commonfate_slack_alert.test", "workflow_id", "test-updated"),
-// 					resource.TestCheckResourceAttr("commonfate_slack_alert.test", "slack_channel_id", "test-updated"),
-// 					resource.TestCheckResourceAttr("commonfate_slack_alert.test", "slack_workspace_id", "test-updated"),
-
-// 					// Verify dynamic values have any value set in the state.
-// 					// resource.TestCheckResourceAttrSet("commonfate_slack_alert.test", "id"),
-// 				),
-// 			},
-// 			// Delete testing automatically occurs in TestCase
-// 		},
-// 	})
-// }
+package slack_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccSlackAlert(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_slack_alert.test",
+			Config: `
+resource "commonfate_slack_alert" "test" {
+  workflow_id        = "aw_123"
+  slack_channel_id   = "C123"
+  slack_workspace_id = "T123"
+}
+`,
+			Check: map[string]string{
+				"workflow_id":        "aw_123",
+				"slack_channel_id":   "C123",
+				"slack_workspace_id": "T123",
+			},
+			UpdatedConfig: `
+resource "commonfate_slack_alert" "test" {
+  workflow_id                         = "aw_123"
+  slack_channel_id                    = "C456"
+  slack_workspace_id                  = "T123"
+  notify_expiry_in_seconds            = 300
+  use_web_console_for_approval_action = true
+}
+`,
+			UpdatedCheck: map[string]string{
+				"slack_channel_id":                    "C456",
+				"notify_expiry_in_seconds":            "300",
+				"use_web_console_for_approval_action": "true",
+			},
+		},
+	})
+}
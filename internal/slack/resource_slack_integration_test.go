@@ -0,0 +1,42 @@
+package slack_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccSlackIntegration(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_slack_integration.test",
+			Config: `
+resource "commonfate_slack_integration" "test" {
+  name                       = "test"
+  client_id                  = "client"
+  client_secret_secret_path  = "/secret"
+  signing_secret_secret_path = "/signing"
+}
+`,
+			Check: map[string]string{
+				"name":                       "test",
+				"client_id":                  "client",
+				"client_secret_secret_path":  "/secret",
+				"signing_secret_secret_path": "/signing",
+			},
+			UpdatedConfig: `
+resource "commonfate_slack_integration" "test" {
+  name                       = "test-updated"
+  client_id                  = "client-updated"
+  client_secret_secret_path  = "/secret"
+  signing_secret_secret_path = "/signing"
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name":      "test-updated",
+				"client_id": "client-updated",
+			},
+		},
+	})
+}
@@ -0,0 +1,126 @@
+package snowflake
+
+import (
+	"context"
+	"fmt"
+
+	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
+	"github.com/common-fate/sdk/service/control/integration"
+	"github.com/common-fate/terraform-provider-commonfate/internal/integrations"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SnowflakeIntegrationDatasourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	AccountID          types.String `tfsdk:"account_id"`
+	Region             types.String `tfsdk:"region"`
+	Username           types.String `tfsdk:"username"`
+	PasswordSecretPath types.String `tfsdk:"password_secret_path"`
+}
+
+// SnowflakeIntegrationDatasource looks up an existing Snowflake integration by ID or name.
+type SnowflakeIntegrationDatasource struct {
+	client integrationv1alpha1connect.IntegrationServiceClient
+}
+
+var (
+	_ datasource.DataSource                     = &SnowflakeIntegrationDatasource{}
+	_ datasource.DataSourceWithConfigValidators = &SnowflakeIntegrationDatasource{}
+)
+
+// Metadata returns the data source type name.
+func (r *SnowflakeIntegrationDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snowflake_integration"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *SnowflakeIntegrationDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	cfg := providerData.Config
+
+	r.client = integration.NewFromConfig(cfg)
+}
+
+func (r *SnowflakeIntegrationDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing Snowflake integration by ID or name.",
+		Attributes: integrations.LookupAttributes("Snowflake", map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The Snowflake Account ID",
+				Computed:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region that the Snowflake instance is hosted in (e.g ap-southeast-2)",
+				Computed:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username of the Snowflake Admin account",
+				Computed:            true,
+			},
+			"password_secret_path": schema.StringAttribute{
+				MarkdownDescription: "Path to secret for the password Snowflake Admin account",
+				Computed:            true,
+			},
+		}),
+	}
+}
+
+func (r *SnowflakeIntegrationDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return integrations.ConfigValidators()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *SnowflakeIntegrationDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HTTP Client",
+			"Expected configured HTTP client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data SnowflakeIntegrationDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integ, diags := integrations.Lookup(ctx, r.client, data.Id, data.Name, "Snowflake", func(c *integrationv1alpha1.Config) bool {
+		return c.GetSnowflake() != nil
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := integ.Config.GetSnowflake()
+
+	state := SnowflakeIntegrationDatasourceModel{
+		Id:                 types.StringValue(integ.Id),
+		Name:               types.StringValue(integ.Name),
+		AccountID:          types.StringValue(config.AccountId),
+		Region:             types.StringValue(config.Region),
+		Username:           types.StringValue(config.Username),
+		PasswordSecretPath: types.StringValue(config.PasswordSecretPath),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,66 @@
+package snowflake_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+const snowflakeIntegrationDatasourceResource = `
+resource "commonfate_snowflake_integration" "test" {
+  name                 = "test"
+  account_id           = "ab12345"
+  region               = "us-east-1"
+  username             = "commonfate"
+  password_secret_path = "/secret"
+}
+`
+
+func TestAccSnowflakeIntegrationDatasource(t *testing.T) {
+	acctest.RunDatasourceTests(t, []acctest.DatasourceTest{
+		{
+			Name:       "by_id",
+			Datasource: "data.commonfate_snowflake_integration.test",
+			Config: snowflakeIntegrationDatasourceResource + `
+data "commonfate_snowflake_integration" "test" {
+  id = commonfate_snowflake_integration.test.id
+}
+`,
+			Check: map[string]string{
+				"name":                 "test",
+				"account_id":           "ab12345",
+				"region":               "us-east-1",
+				"username":             "commonfate",
+				"password_secret_path": "/secret",
+			},
+		},
+		{
+			Name:       "by_name",
+			Datasource: "data.commonfate_snowflake_integration.test",
+			Config: snowflakeIntegrationDatasourceResource + `
+data "commonfate_snowflake_integration" "test" {
+  name = "test"
+
+  depends_on = [commonfate_snowflake_integration.test]
+}
+`,
+			Check: map[string]string{
+				"name":                 "test",
+				"account_id":           "ab12345",
+				"region":               "us-east-1",
+				"username":             "commonfate",
+				"password_secret_path": "/secret",
+			},
+		},
+		{
+			Name: "not_found",
+			Config: `
+data "commonfate_snowflake_integration" "test" {
+  name = "missing"
+}
+`,
+			ExpectError: regexp.MustCompile(`Snowflake Integration Not Found`),
+		},
+	})
+}
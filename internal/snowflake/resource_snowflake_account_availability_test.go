@@ -0,0 +1,40 @@
+package snowflake_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccSnowflakeAccountAvailability(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_snowflake_account_availability.test",
+			Config: `
+resource "commonfate_snowflake_account_availability" "test" {
+  workflow_id            = "aw_123"
+  snowflake_account_id   = "ab12345"
+  snowflake_account_role = "ANALYST"
+}
+`,
+			Check: map[string]string{
+				"workflow_id":            "aw_123",
+				"snowflake_account_id":   "ab12345",
+				"snowflake_account_role": "ANALYST",
+			},
+			UpdatedConfig: `
+resource "commonfate_snowflake_account_availability" "test" {
+  workflow_id            = "aw_456"
+  snowflake_account_id   = "ab12345"
+  snowflake_account_role = "ANALYST"
+  role_priority          = 10
+}
+`,
+			UpdatedCheck: map[string]string{
+				"workflow_id":   "aw_456",
+				"role_priority": "10",
+			},
+		},
+	})
+}
@@ -0,0 +1,40 @@
+package snowflake_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccSnowflakeDatabaseAvailabilities(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_snowflake_database_availabilities.test",
+			Config: `
+resource "commonfate_snowflake_database_availabilities" "test" {
+  workflow_id                    = "aw_123"
+  snowflake_database_selector_id = "sel_123"
+  snowflake_database_role        = "READER"
+}
+`,
+			Check: map[string]string{
+				"workflow_id":                    "aw_123",
+				"snowflake_database_selector_id": "sel_123",
+				"snowflake_database_role":        "READER",
+			},
+			UpdatedConfig: `
+resource "commonfate_snowflake_database_availabilities" "test" {
+  workflow_id                    = "aw_456"
+  snowflake_database_selector_id = "sel_123"
+  snowflake_database_role        = "READER"
+  role_priority                  = 10
+}
+`,
+			UpdatedCheck: map[string]string{
+				"workflow_id":   "aw_456",
+				"role_priority": "10",
+			},
+		},
+	})
+}
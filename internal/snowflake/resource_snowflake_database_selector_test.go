@@ -0,0 +1,42 @@
+package snowflake_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccSnowflakeDatabaseSelector(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_snowflake_database_selector.test",
+			Config: `
+resource "commonfate_snowflake_database_selector" "test" {
+  id                   = "test"
+  name                 = "Test"
+  snowflake_account_id = "ab12345"
+  when                 = "resource.name like \"*ANALYTICS*\""
+}
+`,
+			Check: map[string]string{
+				"id":                   "test",
+				"name":                 "Test",
+				"snowflake_account_id": "ab12345",
+				"when":                 "resource.name like \"*ANALYTICS*\"",
+			},
+			UpdatedConfig: `
+resource "commonfate_snowflake_database_selector" "test" {
+  id                   = "test"
+  name                 = "Test Updated"
+  snowflake_account_id = "ab12345"
+  when                 = "true"
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name": "Test Updated",
+				"when": "true",
+			},
+		},
+	})
+}
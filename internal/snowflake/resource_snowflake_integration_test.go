@@ -0,0 +1,45 @@
+package snowflake_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccSnowflakeIntegration(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_snowflake_integration.test",
+			Config: `
+resource "commonfate_snowflake_integration" "test" {
+  name                 = "test"
+  account_id           = "ab12345"
+  region               = "us-east-1"
+  username             = "commonfate"
+  password_secret_path = "/secret"
+}
+`,
+			Check: map[string]string{
+				"name":                 "test",
+				"account_id":           "ab12345",
+				"region":               "us-east-1",
+				"username":             "commonfate",
+				"password_secret_path": "/secret",
+			},
+			UpdatedConfig: `
+resource "commonfate_snowflake_integration" "test" {
+  name                 = "test-updated"
+  account_id           = "ab12345"
+  region               = "us-east-1"
+  username             = "commonfate"
+  password_secret_path = "/secret-updated"
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name":                 "test-updated",
+				"password_secret_path": "/secret-updated",
+			},
+		},
+	})
+}
@@ -0,0 +1,160 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	integrationv1alpha1 "github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1"
+	"github.com/common-fate/sdk/gen/commonfate/control/integration/v1alpha1/integrationv1alpha1connect"
+	"github.com/common-fate/sdk/service/control/integration"
+	"github.com/common-fate/terraform-provider-commonfate/internal/integrations"
+	"github.com/common-fate/terraform-provider-commonfate/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type WebhookIntegrationDatasourceModel struct {
+	Id                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	URL                     types.String `tfsdk:"url"`
+	SendAuditLogEvents      types.Bool   `tfsdk:"send_audit_log_events"`
+	SendAuthorizationEvents types.Bool   `tfsdk:"send_authorization_events"`
+	Headers                 []Header     `tfsdk:"headers"`
+	FilterForActions        types.Set    `tfsdk:"filter_for_actions"`
+}
+
+// WebhookIntegrationDatasource looks up an existing Webhook integration by ID or name.
+type WebhookIntegrationDatasource struct {
+	client integrationv1alpha1connect.IntegrationServiceClient
+}
+
+var (
+	_ datasource.DataSource                     = &WebhookIntegrationDatasource{}
+	_ datasource.DataSourceWithConfigValidators = &WebhookIntegrationDatasource{}
+)
+
+// Metadata returns the data source type name.
+func (r *WebhookIntegrationDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_integration"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *WebhookIntegrationDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	cfg := providerData.Config
+
+	r.client = integration.NewFromConfig(cfg)
+}
+
+func (r *WebhookIntegrationDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing Webhook integration by ID or name.",
+		Attributes: integrations.LookupAttributes("Webhook", map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL to dispatch webhook events to",
+				Computed:            true,
+			},
+			"send_audit_log_events": schema.BoolAttribute{
+				MarkdownDescription: "Whether Audit Log events are dispatched to the webhook",
+				Computed:            true,
+			},
+			"send_authorization_events": schema.BoolAttribute{
+				MarkdownDescription: "Whether authorization events are dispatched to the webhook",
+				Computed:            true,
+			},
+			"headers": schema.ListNestedAttribute{
+				MarkdownDescription: "HTTP headers to use when sending the webhook event",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The HTTP header key",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The HTTP header value",
+							Computed:            true,
+							Sensitive:           true,
+						},
+					},
+				},
+			},
+			"filter_for_actions": schema.SetAttribute{
+				MarkdownDescription: "Filter for event actions to send to the webhook",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		}),
+	}
+}
+
+func (r *WebhookIntegrationDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return integrations.ConfigValidators()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *WebhookIntegrationDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HTTP Client",
+			"Expected configured HTTP client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data WebhookIntegrationDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integ, diags := integrations.Lookup(ctx, r.client, data.Id, data.Name, "Webhook", func(c *integrationv1alpha1.Config) bool {
+		return c.GetWebhook() != nil
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := integ.Config.GetWebhook()
+
+	var headers []Header
+	for _, h := range config.Headers {
+		headers = append(headers, Header{
+			Key:   types.StringValue(h.Key),
+			Value: types.StringValue(h.Value),
+		})
+	}
+
+	filterForActions, diags := types.SetValueFrom(ctx, types.StringType, config.FilterForActions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := WebhookIntegrationDatasourceModel{
+		Id:                      types.StringValue(integ.Id),
+		Name:                    types.StringValue(integ.Name),
+		URL:                     types.StringValue(config.Url),
+		SendAuditLogEvents:      types.BoolValue(config.SendAuditLogEvents),
+		SendAuthorizationEvents: types.BoolValue(config.SendAuthorizationEvents),
+		Headers:                 headers,
+		FilterForActions:        filterForActions,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,61 @@
+package webhook_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+const webhookIntegrationDatasourceResource = `
+resource "commonfate_webhook_integration" "test" {
+  name = "test"
+  url  = "https://webhook.example.com"
+}
+`
+
+func TestAccWebhookIntegrationDatasource(t *testing.T) {
+	acctest.RunDatasourceTests(t, []acctest.DatasourceTest{
+		{
+			Name:       "by_id",
+			Datasource: "data.commonfate_webhook_integration.test",
+			Config: webhookIntegrationDatasourceResource + `
+data "commonfate_webhook_integration" "test" {
+  id = commonfate_webhook_integration.test.id
+}
+`,
+			Check: map[string]string{
+				"name":                      "test",
+				"url":                       "https://webhook.example.com",
+				"send_audit_log_events":     "false",
+				"send_authorization_events": "false",
+			},
+		},
+		{
+			Name:       "by_name",
+			Datasource: "data.commonfate_webhook_integration.test",
+			Config: webhookIntegrationDatasourceResource + `
+data "commonfate_webhook_integration" "test" {
+  name = "test"
+
+  depends_on = [commonfate_webhook_integration.test]
+}
+`,
+			Check: map[string]string{
+				"name":                      "test",
+				"url":                       "https://webhook.example.com",
+				"send_audit_log_events":     "false",
+				"send_authorization_events": "false",
+			},
+		},
+		{
+			Name: "not_found",
+			Config: `
+data "commonfate_webhook_integration" "test" {
+  name = "missing"
+}
+`,
+			ExpectError: regexp.MustCompile(`Webhook Integration Not Found`),
+		},
+	})
+}
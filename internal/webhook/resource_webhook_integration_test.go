@@ -0,0 +1,49 @@
+package webhook_test
+
+import (
+	"testing"
+
+	"github.com/common-fate/terraform-provider-commonfate/internal/acctest"
+)
+
+func TestAccWebhookIntegration(t *testing.T) {
+	acctest.RunResourceTests(t, []acctest.ResourceTest{
+		{
+			Name:     "basic",
+			Resource: "commonfate_webhook_integration.test",
+			Config: `
+resource "commonfate_webhook_integration" "test" {
+  name = "test"
+  url  = "https://webhook.example.com"
+}
+`,
+			Check: map[string]string{
+				"name":                      "test",
+				"url":                       "https://webhook.example.com",
+				"send_audit_log_events":     "false",
+				"send_authorization_events": "false",
+			},
+			UpdatedConfig: `
+resource "commonfate_webhook_integration" "test" {
+  name                  = "test-updated"
+  url                   = "https://webhook.example.com"
+  send_audit_log_events = true
+  filter_for_actions    = ["grant.activated"]
+  headers = [
+    {
+      key   = "Authorization"
+      value = "Bearer token"
+    },
+  ]
+}
+`,
+			UpdatedCheck: map[string]string{
+				"name":                  "test-updated",
+				"send_audit_log_events": "true",
+				"filter_for_actions.#":  "1",
+				"headers.#":             "1",
+				"headers.0.key":         "Authorization",
+			},
+		},
+	})
+}
@@ -1,7 +1,10 @@
 package eid
 
 import (
+	"fmt"
+
 	entityv1alpha1 "github.com/common-fate/sdk/gen/commonfate/entity/v1alpha1"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -17,6 +20,30 @@ var EIDAttrs = map[string]schema.Attribute{
 	},
 }
 
+// DataSourceEIDAttrs are the attributes of an EID used as an input to a data source.
+var DataSourceEIDAttrs = map[string]datasourceschema.Attribute{
+	"type": datasourceschema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The entity type",
+	},
+	"id": datasourceschema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The entity ID",
+	},
+}
+
+// ComputedDataSourceEIDAttrs are the attributes of an EID returned by a data source.
+var ComputedDataSourceEIDAttrs = map[string]datasourceschema.Attribute{
+	"type": datasourceschema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The entity type",
+	},
+	"id": datasourceschema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The entity ID",
+	},
+}
+
 type EID struct {
 	Type types.String `tfsdk:"type"`
 	ID   types.String `tfsdk:"id"`
@@ -29,6 +56,11 @@ func (u EID) ToAPI() *entityv1alpha1.EID {
 	}
 }
 
+// String formats the EID in Cedar syntax, e.g. AWS::Account::"123456789012".
+func (u EID) String() string {
+	return fmt.Sprintf("%s::%q", u.Type.ValueString(), u.ID.ValueString())
+}
+
 func EIDFromAPI(input *entityv1alpha1.EID) EID {
 	if input == nil {
 		return EID{}